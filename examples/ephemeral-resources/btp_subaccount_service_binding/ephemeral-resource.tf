@@ -0,0 +1,21 @@
+# create a service binding for the run and hand its credentials to a Kubernetes secret,
+# the binding is deleted again once Terraform does not need it anymore
+ephemeral "btp_subaccount_service_binding" "my_binding" {
+  subaccount_id       = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  service_instance_id = "8911491d-0e1d-425d-a233-785512602d6f"
+  name                = "my ephemeral binding"
+}
+
+resource "kubernetes_secret_v1" "my_secret" {
+  metadata {
+    name = "my-secret"
+  }
+
+  data_wo = ephemeral.btp_subaccount_service_binding.my_binding.credentials_map
+}
+
+# look up the credentials of an existing service binding by its name
+ephemeral "btp_subaccount_service_binding" "my_existing_binding" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name          = "my binding"
+}
@@ -16,4 +16,5 @@ resource "btp_directory" "dir_with_features" {
   name        = "my-feat-directory"
   description = "This is a directory with features."
   features    = ["DEFAULT","ENTITLEMENTS","AUTHORIZATIONS"]
+  admins      = ["jane.doe@test.com"]
 }
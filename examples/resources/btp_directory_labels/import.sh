@@ -0,0 +1,3 @@
+# terraform import btp_directory_labels.<resource_name> <directory_id>
+
+terraform import btp_directory_labels.ownership f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d
@@ -0,0 +1,25 @@
+# manage the cost center and owner labels of a directory, other labels are kept
+resource "btp_directory_labels" "ownership" {
+  directory_id = "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+  labels = {
+    "Cost Center" = ["19700626"]
+    "Owner"       = ["jane.doe@test.com"]
+  }
+}
+
+# manage all labels of a directory, labels added elsewhere are removed
+resource "btp_directory_labels" "all" {
+  directory_id = "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+  labels = {
+    "Department" = ["Sales"]
+  }
+  authoritative = true
+}
+
+# a directory whose labels are managed by the btp_directory_labels resources
+resource "btp_directory" "department" {
+  name = "My Department"
+  lifecycle {
+    ignore_changes = [labels]
+  }
+}
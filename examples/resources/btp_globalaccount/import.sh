@@ -0,0 +1,3 @@
+# terraform import btp_globalaccount.<resource_name> <globalaccount_id>
+
+terraform import btp_globalaccount.this 03760ecf-9d89-4189-a92a-1c7efed09298
@@ -0,0 +1,6 @@
+# manage the metadata of the global account the provider is configured for
+resource "btp_globalaccount" "this" {
+  name        = "My Global Account"
+  description = "The global account of the platform team."
+  usage       = "Production"
+}
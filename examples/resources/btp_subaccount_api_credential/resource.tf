@@ -0,0 +1,13 @@
+# create an API credential based on a client secret in a subaccount
+resource "btp_subaccount_api_credential" "my_secret_credential" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name          = "my-automation"
+}
+
+# create a read-only API credential based on a client certificate in a subaccount
+resource "btp_subaccount_api_credential" "my_certificate_credential" {
+  subaccount_id      = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name               = "my-monitoring"
+  certificate_passed = file("${path.module}/client-certificate.pem")
+  read_only          = true
+}
@@ -0,0 +1,3 @@
+# terraform import btp_subaccount_labels.<resource_name> <subaccount_id>
+
+terraform import btp_subaccount_labels.ownership 6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f
@@ -0,0 +1,27 @@
+# manage the cost center and owner labels of a subaccount, other labels are kept
+resource "btp_subaccount_labels" "ownership" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  labels = {
+    "Cost Center" = ["19700626"]
+    "Owner"       = ["jane.doe@test.com"]
+  }
+}
+
+# manage all labels of a subaccount, labels added elsewhere are removed
+resource "btp_subaccount_labels" "all" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  labels = {
+    "Department" = ["Sales"]
+  }
+  authoritative = true
+}
+
+# a subaccount whose labels are managed by the btp_subaccount_labels resources
+resource "btp_subaccount" "project" {
+  name      = "My Project"
+  subdomain = "my-project"
+  region    = "us10"
+  lifecycle {
+    ignore_changes = [labels]
+  }
+}
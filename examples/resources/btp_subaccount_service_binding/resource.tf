@@ -15,3 +15,13 @@ resource "btp_subaccount_service_binding" "my_parameterized_binding" {
     param_b = ""
   })
 }
+
+# create a service binding whose credentials are rotated every 30 days,
+# the previous credentials remain valid for two more days
+resource "btp_subaccount_service_binding" "my_rotating_binding" {
+  subaccount_id       = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  service_instance_id = "8911491d-0e1d-425d-a233-785512602d6f"
+  name                = "my-rotating-binding"
+  rotation_period     = "720h"
+  rotation_overlap    = "48h"
+}
@@ -0,0 +1,3 @@
+# terraform import btp_subaccount_service_broker.<resource_name> <subaccount_id>,<service_broker_id>
+
+terraform import btp_subaccount_service_broker.my_broker 6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,b37fa6a2-e4b8-4fa3-8cb6-f1b3ea6e5af1
\ No newline at end of file
@@ -0,0 +1,12 @@
+# register a custom service broker in a subaccount
+resource "btp_subaccount_service_broker" "my_broker" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name          = "my-service-broker"
+  description   = "Broker for the services of my team"
+  url           = "https://my-service-broker.example.com"
+  username      = var.broker_username
+  password      = var.broker_password
+  labels = {
+    "team" = ["platform"]
+  }
+}
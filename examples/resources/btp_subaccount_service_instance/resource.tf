@@ -30,4 +30,22 @@ resource "btp_subaccount_service_instance" "alert_notification_free" {
     update = "15m"
     delete = "15m"
   }
+}
+
+# create a HANA Cloud instance and share it with the other environments of the subaccount,
+# e.g. Cloud Foundry spaces and Kyma namespaces
+resource "btp_subaccount_service_instance" "hana_cloud_shared" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  # The service plan ID can be looked up via the data source btp_subaccount_service_plan
+  serviceplan_id = "dd5ea1a1-0c1e-4a21-9b58-1c2b1b6b3f0e" # hana-cloud - hana
+  name           = "my-hana-cloud-instance"
+  shared         = true
+}
+
+# create an instance of the alert-notification service by the names of the service offering and plan
+resource "btp_subaccount_service_instance" "alert_notification_by_name" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  offering_name = "alert-notification"
+  plan_name     = "free"
+  name          = "my-alert-notification-instance"
 }
\ No newline at end of file
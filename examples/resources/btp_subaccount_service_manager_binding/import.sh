@@ -0,0 +1,3 @@
+# terraform import btp_subaccount_service_manager_binding.<resource_name> <subaccount_id>,<name>
+
+terraform import btp_subaccount_service_manager_binding.my_sm_binding 6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,my-service-operator
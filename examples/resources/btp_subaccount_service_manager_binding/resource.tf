@@ -0,0 +1,6 @@
+# create a binding of the SAP Service Manager in a subaccount,
+# e.g. to bootstrap the SAP BTP service operator in a Kubernetes cluster
+resource "btp_subaccount_service_manager_binding" "my_sm_binding" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name          = "my-service-operator"
+}
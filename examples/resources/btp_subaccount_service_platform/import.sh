@@ -0,0 +1,3 @@
+# terraform import btp_subaccount_service_platform.<resource_name> <subaccount_id>,<platform_id>
+
+terraform import btp_subaccount_service_platform.my_cluster 6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,e1c2a3b4-5678-4d9e-8f0a-1b2c3d4e5f6a
\ No newline at end of file
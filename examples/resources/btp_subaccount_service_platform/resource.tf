@@ -0,0 +1,13 @@
+# register a Kubernetes cluster as platform in a subaccount
+resource "btp_subaccount_service_platform" "my_cluster" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name          = "my-kubernetes-cluster"
+  type          = "kubernetes"
+  description   = "Cluster of my team"
+}
+
+# the credentials are needed to deploy the Service Manager agent in the cluster
+output "platform_credentials" {
+  value     = jsondecode(btp_subaccount_service_platform.my_cluster.credentials)
+  sensitive = true
+}
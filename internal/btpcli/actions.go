@@ -11,6 +11,7 @@ const (
 	ActionEnable      Action = "enable"
 	ActionGet         Action = "get"
 	ActionList        Action = "list"
+	ActionMove        Action = "move"
 	ActionRegister    Action = "register"
 	ActionRemove      Action = "remove"
 	ActionShare       Action = "share"
@@ -62,6 +63,11 @@ func NewListRequest(command string, args any) *CommandRequest {
 	return NewCommandRequest(ActionList, command, args)
 }
 
+// NewMoveRequest creates a new move request
+func NewMoveRequest(command string, args any) *CommandRequest {
+	return NewCommandRequest(ActionMove, command, args)
+}
+
 // NewRegisterRequest creates a new register request
 func NewRegisterRequest(command string, args any) *CommandRequest {
 	return NewCommandRequest(ActionRegister, command, args)
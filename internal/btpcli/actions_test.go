@@ -44,6 +44,10 @@ func TestNewListRequest(t *testing.T) {
 	assertAction(t, ActionList, NewListRequest)
 }
 
+func TestNewMoveRequest(t *testing.T) {
+	assertAction(t, ActionMove, NewMoveRequest)
+}
+
 func TestNewRegisterRequest(t *testing.T) {
 	assertAction(t, ActionRegister, NewRegisterRequest)
 }
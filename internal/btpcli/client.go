@@ -4,15 +4,20 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"path"
 	"strconv"
+	"strings"
+	"time"
 
 	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const DefaultServerURL string = "https://cli.btp.cloud.sap"
@@ -21,10 +26,33 @@ func NewV2Client(serverURL *url.URL) *v2Client {
 	return NewV2ClientWithHttpClient(http.DefaultClient, serverURL)
 }
 
+// NewV2ClientWithTransportConfig creates a client which connects to the CLI server and the identity provider with the given connection settings.
+// The settings of the given http client, e.g. its timeout, are kept and the connection settings are applied to its transport.
+func NewV2ClientWithTransportConfig(client *http.Client, config TransportConfig, serverURL *url.URL) (*v2Client, error) {
+	transport, err := config.WrapTransport(client.Transport)
+
+	if err != nil {
+		return nil, err
+	}
+
+	clientWithTransport := *client
+	clientWithTransport.Transport = transport
+
+	v2 := NewV2ClientWithHttpClient(&clientWithTransport, serverURL)
+	v2.transportConfig = config
+
+	return v2, nil
+}
+
 func NewV2ClientWithHttpClient(client *http.Client, serverURL *url.URL) *v2Client {
+	// the client is copied, so that shared clients like the http.DefaultClient are not wrapped more than once
+	clientCopy := *client
+
 	return &v2Client{
-		httpClient: injectBTPCLITransport(client),
-		serverURL:  serverURL,
+		httpClient:     injectBTPCLITransport(&clientCopy),
+		serverURL:      serverURL,
+		RetryPolicy:    DefaultRetryPolicy,
+		serverWarnings: newServerWarnings(),
 		newCorrelationID: func() string {
 			val, err := uuid.GenerateUUID()
 			if err != nil {
@@ -54,20 +82,52 @@ const cliTargetProtocolVersion string = "v2.49.0"
 type v2ContextKey string
 
 type v2Client struct {
-	httpClient *http.Client
-	serverURL  *url.URL
+	httpClient      *http.Client
+	serverURL       *url.URL
+	transportConfig TransportConfig
 
 	newCorrelationID func() string
 
-	session   *Session
-	UserAgent string
+	session     *Session
+	relogin     func(ctx context.Context) (*Session, error)
+	UserAgent   string
+	RetryPolicy RetryPolicy
+
+	// SessionCache is used to reuse the sessions of earlier logins. It is disabled if nil.
+	SessionCache *SessionCache
+
+	limiter        *requestLimiter
+	readCache      *readCache
+	serverWarnings *serverWarnings
+}
+
+// contextKeySubaccount carries the subaccount of a modifying command, so that the requests can be serialized per subaccount
+const contextKeySubaccount v2ContextKey = "subaccount"
+
+// SetRequestLimits configures how the requests to the CLI server are throttled. The requests are not throttled at all
+// unless a limit is set.
+func (v2 *v2Client) SetRequestLimits(limits RequestLimits) {
+	v2.limiter = newRequestLimiter(limits)
+}
+
+// LockSubaccount serializes a sequence of modifying commands against the subaccount, if the requests per subaccount are
+// serialized. The commands must be executed with the returned context and the returned function must be called once
+// the sequence has completed.
+func (v2 *v2Client) LockSubaccount(ctx context.Context, subaccountId string) (context.Context, func(), error) {
+	return v2.limiter.lockSubaccount(ctx, subaccountId)
+}
+
+// EnableReadCache memoizes the responses of get and list commands for the given time. Any other command invalidates
+// the cached responses of the subaccount, directory or global account it refers to.
+func (v2 *v2Client) EnableReadCache(ttl time.Duration) {
+	v2.readCache = newReadCache(ttl)
 }
 
 func (v2 *v2Client) initTrace(ctx context.Context) context.Context {
 	return context.WithValue(ctx, v2ContextKey(HeaderCorrelationID), v2.newCorrelationID())
 }
 
-func (v2 *v2Client) doRequest(ctx context.Context, method string, endpoint string, body any) (*http.Response, error) {
+func (v2 *v2Client) doRequest(ctx context.Context, method string, endpoint string, body any, session *Session) (*http.Response, error) {
 	endpointURL, err := url.Parse(endpoint)
 
 	if err != nil {
@@ -95,26 +155,73 @@ func (v2 *v2Client) doRequest(ctx context.Context, method string, endpoint strin
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set(HeaderCLIFormat, "json")
 
-	if v2.session != nil {
-		v2.session.Lock()
-		defer v2.session.Unlock()
-
-		req.Header.Set(HeaderCLISessionId, v2.session.SessionId)
-		req.Header.Set(HeaderCLISubdomain, v2.session.GlobalAccountSubdomain)
-		req.Header.Set(HeaderCLICustomIDP, v2.session.IdentityProvider)
+	if session != nil {
+		// the session is only locked while reading it, so that requests get sent concurrently but wait for a renewal in progress
+		session.Lock()
+		req.Header.Set(HeaderCLISessionId, session.SessionId)
+		req.Header.Set(HeaderCLISubdomain, session.GlobalAccountSubdomain)
+		req.Header.Set(HeaderCLICustomIDP, session.IdentityProvider)
+		session.Unlock()
 	}
 
 	if correlationID := ctx.Value(v2ContextKey(HeaderCorrelationID)); correlationID != nil {
 		req.Header.Set(HeaderCorrelationID, correlationID.(string))
 	}
 
+	subaccount, _ := ctx.Value(contextKeySubaccount).(string)
+	release, err := v2.limiter.acquire(ctx, subaccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
 	res, err := v2.httpClient.Do(req)
 
+	if err == nil {
+		v2.serverWarnings.collect(ctx, res)
+	}
+
 	return res, err
 }
 
+// TakeServerWarnings returns the announcements of the CLI server, e.g. deprecations or pending protocol changes, which
+// have been received since the last call. Every announcement is returned only once.
+func (v2 *v2Client) TakeServerWarnings() []string {
+	return v2.serverWarnings.take()
+}
+
+// doPostRequest sends a request without session, as required for the login
 func (v2 *v2Client) doPostRequest(ctx context.Context, endpoint string, body any) (*http.Response, error) {
-	return v2.doRequest(ctx, http.MethodPost, endpoint, body)
+	return v2.doRequest(ctx, http.MethodPost, endpoint, body, nil)
+}
+
+// doPostRequestWithRetry sends a request within the current session and repeats it according to the retry policy as long as the response indicates a transient failure
+func (v2 *v2Client) doPostRequestWithRetry(ctx context.Context, endpoint string, body any, retriable bool) (*http.Response, error) {
+	for retry := 0; ; retry++ {
+		res, err := v2.doRequest(ctx, http.MethodPost, endpoint, body, v2.session)
+
+		if err != nil || !retriable || retry >= v2.RetryPolicy.MaxRetries || !isRetriableResponse(res) {
+			return res, err
+		}
+
+		backoff := v2.RetryPolicy.backoff(retry, res.Header.Get(HeaderRetryAfter))
+
+		discardResponse(res)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// discardResponse consumes and closes the body of a response that is not processed any further, so that the connection can be reused
+func discardResponse(res *http.Response) {
+	_, _ = io.Copy(io.Discard, res.Body)
+	res.Body.Close()
 }
 
 func (v2 *v2Client) parseResponse(ctx context.Context, res *http.Response, targetObj any, goodState int, knownErrorStates map[int]string) error {
@@ -134,23 +241,166 @@ func (v2 *v2Client) checkResponseForErrors(ctx context.Context, res *http.Respon
 		return nil
 	}
 
-	var err error
+	errorMsg, known := knownErrorStates[res.StatusCode]
+
+	if !known {
+		errorMsg = v2.parseResponseError(ctx, res)
+	}
 
-	if errorMsg, known := knownErrorStates[res.StatusCode]; known {
-		err = fmt.Errorf("%s", errorMsg)
-	} else {
-		err = v2.parseResponseError(ctx, res)
+	return &BackendError{
+		StatusCode:    res.StatusCode,
+		CorrelationID: correlationIDFrom(ctx),
+		Message:       errorMsg,
 	}
+}
 
-	return fmt.Errorf("%w [Status: %d; Correlation ID: %s]", err, res.StatusCode, ctx.Value(v2ContextKey(HeaderCorrelationID)))
+func (v2 *v2Client) parseResponseError(ctx context.Context, res *http.Response) string {
+	return "received response with unexpected status"
 }
 
-func (v2 *v2Client) parseResponseError(ctx context.Context, res *http.Response) error {
-	return fmt.Errorf("received response with unexpected status")
+// correlationIDFrom returns the correlation ID of the trace initialized for the given context
+func correlationIDFrom(ctx context.Context) string {
+	correlationID, _ := ctx.Value(v2ContextKey(HeaderCorrelationID)).(string)
+	return correlationID
 }
 
 // Login authenticates a user using username + password
 func (v2 *v2Client) Login(ctx context.Context, loginReq *LoginRequest) (*LoginResponse, error) {
+	cacheKey := sessionCacheKey(v2.serverURL, loginReq.GlobalAccountSubdomain, loginReq.IdentityProvider, loginReq.Username)
+
+	return v2.establishSession(ctx, cacheKey, func(ctx context.Context) (*LoginResponse, *Session, error) {
+		return v2.login(ctx, loginReq)
+	})
+}
+
+// IdTokenLogin authenticates a user by providing an id token
+func (v2 *v2Client) IdTokenLogin(ctx context.Context, loginReq *IdTokenLoginRequest) (*LoginResponse, error) {
+	return v2.establishSession(ctx, "", func(ctx context.Context) (*LoginResponse, *Session, error) {
+		return v2.idTokenLogin(ctx, loginReq)
+	})
+}
+
+// IdTokenFileLogin authenticates a user by providing an id token read from a file. The file is read again whenever
+// the session needs to be renewed.
+func (v2 *v2Client) IdTokenFileLogin(ctx context.Context, loginReq *IdTokenFileLoginRequest) (*LoginResponse, error) {
+	return v2.establishSession(ctx, "", func(ctx context.Context) (*LoginResponse, *Session, error) {
+		idToken, err := readIdTokenFile(loginReq.IdTokenFile)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return v2.idTokenLogin(ctx, NewIdTokenLoginRequest(loginReq.GlobalAccountSubdomain, idToken))
+	})
+}
+
+// PasscodeLogin authenticates with a pem encoded x509 key-pair
+func (v2 *v2Client) PasscodeLogin(ctx context.Context, loginReq *PasscodeLoginRequest) (*LoginResponse, error) {
+	cacheKey := sessionCacheKey(v2.serverURL, loginReq.GlobalAccountSubdomain, loginReq.IdentityProvider, loginReq.Username)
+
+	return v2.establishSession(ctx, cacheKey, func(ctx context.Context) (*LoginResponse, *Session, error) {
+		return v2.passcodeLogin(ctx, loginReq)
+	})
+}
+
+// establishSession executes the given login flow and keeps it to be able to renew the session once it expires. If a
+// session cache is configured and the cache key is given, a valid cached session is reused instead of logging in.
+func (v2 *v2Client) establishSession(ctx context.Context, cacheKey string, loginFlow func(ctx context.Context) (*LoginResponse, *Session, error)) (*LoginResponse, error) {
+	useCache := v2.SessionCache != nil && len(cacheKey) > 0
+
+	login := func(ctx context.Context) (*LoginResponse, *Session, error) {
+		loginResponse, session, err := loginFlow(ctx)
+
+		if err == nil && useCache {
+			if err := v2.SessionCache.store(cacheKey, session); err != nil {
+				tflog.Warn(ctx, "Unable to cache the session", map[string]interface{}{"error": err.Error()})
+			}
+		}
+
+		return loginResponse, session, err
+	}
+
+	relogin := func(ctx context.Context) (*Session, error) {
+		_, session, err := login(ctx)
+		return session, err
+	}
+
+	if useCache {
+		if session, found := v2.SessionCache.load(cacheKey); found {
+			if v2.isSessionValid(ctx, session) {
+				v2.session = session
+				v2.relogin = relogin
+
+				return &LoginResponse{
+					Username: session.LoggedInUser.Username,
+					Email:    session.LoggedInUser.Email,
+					Issuer:   session.LoggedInUser.Issuer,
+				}, nil
+			}
+
+			_ = v2.SessionCache.remove(cacheKey)
+		}
+	}
+
+	loginResponse, session, err := login(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	v2.session = session
+	v2.relogin = relogin
+
+	return loginResponse, nil
+}
+
+// isSessionValid checks whether the CLI server still accepts the given session by retrieving the global account
+func (v2 *v2Client) isSessionValid(ctx context.Context, session *Session) bool {
+	ctx = v2.initTrace(ctx)
+
+	endpoint := fmt.Sprintf("%s?%s", path.Join("command", cliTargetProtocolVersion, "accounts/global-account"), ActionGet)
+	args := struct {
+		ParamValues any `json:"paramValues"`
+	}{
+		ParamValues: map[string]string{"globalAccount": session.GlobalAccountSubdomain},
+	}
+
+	res, err := v2.doRequest(ctx, http.MethodPost, endpoint, args, session)
+
+	if err != nil {
+		return false
+	}
+
+	discardResponse(res)
+
+	return res.StatusCode == http.StatusOK
+}
+
+// reauthenticate renews the current session by repeating the login flow. In case the session has been renewed
+// concurrently in the meantime, the login is skipped.
+func (v2 *v2Client) reauthenticate(ctx context.Context, expiredSessionId string) error {
+	v2.session.Lock()
+	defer v2.session.Unlock()
+
+	if v2.session.SessionId != expiredSessionId {
+		return nil
+	}
+
+	session, err := v2.relogin(ctx)
+
+	if err != nil {
+		return fmt.Errorf("unable to renew the expired session: %w", err)
+	}
+
+	v2.session.SessionId = session.SessionId
+	v2.session.GlobalAccountSubdomain = session.GlobalAccountSubdomain
+	v2.session.IdentityProvider = session.IdentityProvider
+	v2.session.LoggedInUser = session.LoggedInUser
+
+	return nil
+}
+
+func (v2 *v2Client) login(ctx context.Context, loginReq *LoginRequest) (*LoginResponse, *Session, error) {
 	ctx = v2.initTrace(ctx)
 
 	// TODO: After the switch to client protocol v2.49.0 the terraform provider is still providing
@@ -159,7 +409,7 @@ func (v2 *v2Client) Login(ctx context.Context, loginReq *LoginRequest) (*LoginRe
 	res, err := v2.doPostRequest(ctx, path.Join("login", cliTargetProtocolVersion), loginReq)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var loginResponse LoginResponse
@@ -172,10 +422,10 @@ func (v2 *v2Client) Login(ctx context.Context, loginReq *LoginRequest) (*LoginRe
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	v2.session = &Session{
+	return &loginResponse, &Session{
 		GlobalAccountSubdomain: loginReq.GlobalAccountSubdomain,
 		IdentityProvider:       loginReq.IdentityProvider,
 		LoggedInUser: &v2LoggedInUser{
@@ -184,19 +434,16 @@ func (v2 *v2Client) Login(ctx context.Context, loginReq *LoginRequest) (*LoginRe
 			Issuer:   loginResponse.Issuer,
 		},
 		SessionId: res.Header.Get(HeaderCLISessionId),
-	}
-
-	return &loginResponse, nil
+	}, nil
 }
 
-// IdTokenLogin authenticates a user by providing an id token
-func (v2 *v2Client) IdTokenLogin(ctx context.Context, loginReq *IdTokenLoginRequest) (*LoginResponse, error) {
+func (v2 *v2Client) idTokenLogin(ctx context.Context, loginReq *IdTokenLoginRequest) (*LoginResponse, *Session, error) {
 	ctx = v2.initTrace(ctx)
 
 	res, err := v2.doPostRequest(ctx, path.Join("login", cliTargetProtocolVersion, "idtoken"), loginReq)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var loginResponse LoginResponse
@@ -209,10 +456,10 @@ func (v2 *v2Client) IdTokenLogin(ctx context.Context, loginReq *IdTokenLoginRequ
 	})
 
 	if err != nil && err.Error() != "EOF" { // TODO: stop ignoring EOF when btp CLI server returning non-empty body has reached productive landscapes
-		return nil, err
+		return nil, nil, err
 	}
 
-	v2.session = &Session{
+	return &loginResponse, &Session{
 		GlobalAccountSubdomain: loginReq.GlobalAccountSubdomain,
 		IdentityProvider:       loginResponse.Issuer,
 		LoggedInUser: &v2LoggedInUser{
@@ -221,47 +468,55 @@ func (v2 *v2Client) IdTokenLogin(ctx context.Context, loginReq *IdTokenLoginRequ
 			Issuer:   loginResponse.Issuer,
 		},
 		SessionId: res.Header.Get(HeaderCLISessionId),
-	}
-
-	return &loginResponse, nil
+	}, nil
 }
 
-// PasscodeLogin authenticates with a pem encoded x509 key-pair
-func (v2 *v2Client) PasscodeLogin(ctx context.Context, loginReq *PasscodeLoginRequest) (*LoginResponse, error) {
-	ctx = v2.initTrace(ctx)
+// readIdTokenFile returns the id token stored in the given file
+func readIdTokenFile(idTokenFile string) (string, error) {
+	content, err := os.ReadFile(idTokenFile)
 
-	clientCert, err := tls.X509KeyPair([]byte(loginReq.PEMEncodedCertificate), []byte(loginReq.PEMEncodedPrivateKey))
 	if err != nil {
-		return nil, err
+		return "", fmt.Errorf("unable to read the id token file: %w", err)
 	}
 
-	caCertPool, err := x509.SystemCertPool()
+	idToken := strings.TrimSpace(string(content))
 
+	if len(idToken) == 0 {
+		return "", fmt.Errorf("the id token file '%s' is empty", idTokenFile)
+	}
+
+	return idToken, nil
+}
+
+func (v2 *v2Client) passcodeLogin(ctx context.Context, loginReq *PasscodeLoginRequest) (*LoginResponse, *Session, error) {
+	ctx = v2.initTrace(ctx)
+
+	clientCert, err := tls.X509KeyPair([]byte(loginReq.PEMEncodedCertificate), []byte(loginReq.PEMEncodedPrivateKey))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	idpTransportConfig := v2.transportConfig
 	if len(loginReq.PEMEncodedCACerts) > 0 {
-		caCertPool.AppendCertsFromPEM([]byte(loginReq.PEMEncodedCACerts))
+		idpTransportConfig.PEMEncodedCACerts = strings.Join([]string{idpTransportConfig.PEMEncodedCACerts, loginReq.PEMEncodedCACerts}, "\n")
 	}
 
-	tlsConfig := &tls.Config{
-		Certificates: []tls.Certificate{clientCert},
-		RootCAs:      caCertPool,
+	tlsTransport, err := idpTransportConfig.NewTransport()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	tlsTransport := (http.DefaultTransport.(*http.Transport)).Clone()
-	tlsTransport.TLSClientConfig = tlsConfig
+	tlsTransport.TLSClientConfig.Certificates = []tls.Certificate{clientCert}
 	idpClient := &http.Client{Transport: tlsTransport}
 
 	res, err := idpClient.Get(fmt.Sprintf("%s/service/users/passcode", loginReq.IdentityProviderURL)) // TODO use URL
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("IDP responded with unexpected response code: %d", res.StatusCode)
+		return nil, nil, fmt.Errorf("IDP responded with unexpected response code: %d", res.StatusCode)
 	}
 
 	var passcodeResponse struct {
@@ -269,10 +524,10 @@ func (v2 *v2Client) PasscodeLogin(ctx context.Context, loginReq *PasscodeLoginRe
 	}
 
 	if err := json.NewDecoder(res.Body).Decode(&passcodeResponse); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return v2.Login(ctx, &LoginRequest{
+	return v2.login(ctx, &LoginRequest{
 		IdentityProvider:       loginReq.IdentityProvider,
 		GlobalAccountSubdomain: loginReq.GlobalAccountSubdomain,
 		Username:               loginReq.Username,
@@ -280,8 +535,43 @@ func (v2 *v2Client) PasscodeLogin(ctx context.Context, loginReq *PasscodeLoginRe
 	})
 }
 
-// Execute executes a command
-func (v2 *v2Client) Execute(ctx context.Context, cmdReq *CommandRequest, options ...CommandOptions) (cmdRes CommandResponse, err error) {
+// Execute executes a command. Get and list commands are served from the read cache, if enabled.
+func (v2 *v2Client) Execute(ctx context.Context, cmdReq *CommandRequest, options ...CommandOptions) (CommandResponse, error) {
+	if v2.readCache == nil {
+		return v2.execute(ctx, cmdReq, options...)
+	}
+
+	scope := scopeOf(cmdReq.Args)
+
+	if !isIdempotentAction(cmdReq.Action) {
+		// failed commands invalidate the cache as well, since they may have been applied partially
+		defer v2.readCache.invalidate(scope)
+
+		return v2.execute(ctx, cmdReq, options...)
+	}
+
+	key, err := readCacheKey(cmdReq)
+
+	if err != nil {
+		return v2.execute(ctx, cmdReq, options...)
+	}
+
+	if bypass, _ := ctx.Value(contextKeyWithoutReadCache).(bool); !bypass {
+		if cmdRes, found := v2.readCache.get(key); found {
+			return cmdRes, nil
+		}
+	}
+
+	cmdRes, err := v2.execute(ctx, cmdReq, options...)
+
+	if err != nil {
+		return cmdRes, err
+	}
+
+	return v2.readCache.put(key, scope, cmdRes)
+}
+
+func (v2 *v2Client) execute(ctx context.Context, cmdReq *CommandRequest, options ...CommandOptions) (cmdRes CommandResponse, err error) {
 	ctx = v2.initTrace(ctx)
 
 	wrappedArgs := struct {
@@ -290,17 +580,43 @@ func (v2 *v2Client) Execute(ctx context.Context, cmdReq *CommandRequest, options
 		ParamValues: cmdReq.Args,
 	}
 
-	res, err := v2.doPostRequest(ctx, fmt.Sprintf("%s?%s", path.Join("command", cliTargetProtocolVersion, cmdReq.Command), cmdReq.Action), wrappedArgs)
+	opts := firstElementOrDefault(options, CommandOptions{GoodState: http.StatusOK, KnownErrorStates: map[int]string{}})
+
+	endpoint := fmt.Sprintf("%s?%s", path.Join("command", cliTargetProtocolVersion, cmdReq.Command), cmdReq.Action)
+	retriable := isIdempotentAction(cmdReq.Action) || opts.Retriable
+
+	if !isIdempotentAction(cmdReq.Action) {
+		ctx = context.WithValue(ctx, contextKeySubaccount, subaccountOf(cmdReq.Args))
+	}
+
+	res, err := v2.doPostRequestWithRetry(ctx, endpoint, wrappedArgs, retriable)
 
 	if err != nil {
 		return
 	}
 
-	opts := firstElementOrDefault(options, CommandOptions{GoodState: http.StatusOK, KnownErrorStates: map[int]string{}})
+	if res.StatusCode == http.StatusUnauthorized && v2.relogin != nil {
+		// the session has expired, so the command gets replayed exactly once within a renewed session
+		discardResponse(res)
+
+		if err = v2.reauthenticate(ctx, res.Request.Header.Get(HeaderCLISessionId)); err != nil {
+			return
+		}
+
+		if res, err = v2.doPostRequestWithRetry(ctx, endpoint, wrappedArgs, retriable); err != nil {
+			return
+		}
+	}
+
 	opts.KnownErrorStates[http.StatusGatewayTimeout] = "Command timed out. Please try again later."
 	opts.KnownErrorStates[http.StatusForbidden] = "Access forbidden due to insufficient authorization. Make sure to have sufficient access rights."
 
 	if err = v2.checkResponseForErrors(ctx, res, opts.GoodState, opts.KnownErrorStates); err != nil {
+		var backendErr *BackendError
+		if errors.As(err, &backendErr) {
+			backendErr.Command = cmdReq.Command
+			backendErr.Action = cmdReq.Action
+		}
 		return
 	}
 
@@ -310,18 +626,29 @@ func (v2 *v2Client) Execute(ctx context.Context, cmdReq *CommandRequest, options
 	}
 
 	if cmdRes.StatusCode >= 400 {
+		backendErr := &BackendError{
+			Command:           cmdReq.Command,
+			Action:            cmdReq.Action,
+			StatusCode:        res.StatusCode,
+			BackendStatusCode: cmdRes.StatusCode,
+			BackendMessage:    res.Header.Get(HeaderCLIBackendMessage),
+			CorrelationID:     correlationIDFrom(ctx),
+		}
+
 		var backendError struct {
 			Message string `json:"error"`
 		}
 
-		if err = json.NewDecoder(res.Body).Decode(&backendError); err == nil {
-			err = fmt.Errorf(backendError.Message)
+		if decodeErr := json.NewDecoder(res.Body).Decode(&backendError); decodeErr == nil {
+			backendErr.BackendMessage = backendError.Message
+			backendErr.Message = backendError.Message
 		} else if res.Header.Get(HeaderCLIServerMessage) != "" {
-			err = fmt.Errorf("the backend responded with an error: %s", res.Header.Get(HeaderCLIServerMessage))
+			backendErr.Message = fmt.Sprintf("the backend responded with an error: %s", res.Header.Get(HeaderCLIServerMessage))
 		} else {
-			err = fmt.Errorf("the backend responded with an unknown error: %d", cmdRes.StatusCode)
+			backendErr.Message = fmt.Sprintf("the backend responded with an unknown error: %d", cmdRes.StatusCode)
 		}
 
+		err = backendErr
 		return
 	}
 
@@ -16,9 +16,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
 	"path"
+	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -49,6 +53,11 @@ func TestV2Client_New(t *testing.T) {
 
 		assert.Equal(t, fakeURL, uut.serverURL)
 	})
+	t.Run("default retry policy set", func(t *testing.T) {
+		uut := NewV2Client(fakeURL)
+
+		assert.Equal(t, DefaultRetryPolicy, uut.RetryPolicy)
+	})
 }
 
 func TestV2Client_ProtocolVersion(t *testing.T) {
@@ -443,6 +452,16 @@ func TestV2Client_Execute(t *testing.T) {
 
 		assert.EqualError(t, err, "this is a backend error")
 		assert.Equal(t, 500, cmdRes.StatusCode)
+
+		var backendErr *BackendError
+		if assert.ErrorAs(t, err, &backendErr) {
+			assert.Equal(t, "subaccount/role", backendErr.Command)
+			assert.Equal(t, ActionGet, backendErr.Action)
+			assert.Equal(t, http.StatusOK, backendErr.StatusCode)
+			assert.Equal(t, 500, backendErr.BackendStatusCode)
+			assert.Equal(t, "this is a backend error", backendErr.BackendMessage)
+			assert.NotEmpty(t, backendErr.CorrelationID)
+		}
 	})
 	t.Run("backend error handling - incompatible error message", func(t *testing.T) {
 		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -469,9 +488,587 @@ func TestV2Client_Execute(t *testing.T) {
 
 		assert.EqualError(t, err, "the backend responded with an unknown error: 500")
 		assert.Equal(t, 500, cmdRes.StatusCode)
+
+		var backendErr *BackendError
+		if assert.ErrorAs(t, err, &backendErr) {
+			assert.Equal(t, 500, backendErr.BackendStatusCode)
+			assert.Empty(t, backendErr.BackendMessage)
+		}
+	})
+	t.Run("server error handling", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer srv.Close()
+
+		srvUrl, _ := url.Parse(srv.URL)
+		uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl)
+		uut.newCorrelationID = func() string {
+			return "fake-correlation-id"
+		}
+
+		_, err := uut.Execute(context.TODO(), NewCreateRequest("subaccount/role", map[string]string{}))
+
+		assert.EqualError(t, err, "Access forbidden due to insufficient authorization. Make sure to have sufficient access rights. [Status: 403; Correlation ID: fake-correlation-id]")
+
+		var backendErr *BackendError
+		if assert.ErrorAs(t, err, &backendErr) {
+			assert.Equal(t, "subaccount/role", backendErr.Command)
+			assert.Equal(t, ActionCreate, backendErr.Action)
+			assert.Equal(t, http.StatusForbidden, backendErr.StatusCode)
+			assert.Zero(t, backendErr.BackendStatusCode)
+			assert.Equal(t, "fake-correlation-id", backendErr.CorrelationID)
+		}
+	})
+}
+
+func TestV2Client_ExecuteWithRetry(t *testing.T) {
+	t.Parallel()
+
+	fastRetryPolicy := RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+	simulateRetries := func(t *testing.T, cmdReq *CommandRequest, options []CommandOptions, statusCodes ...int) (int, CommandResponse, error) {
+		var calls int
+		var correlationIDs []string
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			correlationIDs = append(correlationIDs, r.Header.Get(HeaderCorrelationID))
+
+			status := statusCodes[min(calls, len(statusCodes)-1)]
+			calls++
+
+			w.Header().Set(HeaderCLIBackendStatus, "200")
+			if status == http.StatusTooManyRequests {
+				w.Header().Set(HeaderRetryAfter, "0")
+			}
+			w.WriteHeader(status)
+			fmt.Fprintf(w, "{}")
+		}))
+		defer srv.Close()
+
+		srvUrl, _ := url.Parse(srv.URL)
+		uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl)
+		uut.RetryPolicy = fastRetryPolicy
+
+		res, err := uut.Execute(context.TODO(), cmdReq, options...)
+
+		for _, correlationID := range correlationIDs {
+			assert.Equal(t, correlationIDs[0], correlationID, "retries must keep the correlation ID")
+		}
+
+		return calls, res, err
+	}
+
+	t.Run("happy path - get is retried until it succeeds", func(t *testing.T) {
+		calls, res, err := simulateRetries(t, NewGetRequest("subaccount/role", map[string]string{}), nil, http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, 200, res.StatusCode)
+	})
+	t.Run("happy path - list is retried on bad gateway", func(t *testing.T) {
+		calls, _, err := simulateRetries(t, NewListRequest("subaccount/role", map[string]string{}), nil, http.StatusBadGateway, http.StatusOK)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+	t.Run("happy path - non-idempotent command opts into retries", func(t *testing.T) {
+		calls, _, err := simulateRetries(t, NewCreateRequest("subaccount/role", map[string]string{}), []CommandOptions{{GoodState: http.StatusOK, KnownErrorStates: map[int]string{}, Retriable: true}}, http.StatusServiceUnavailable, http.StatusOK)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+	t.Run("error path - non-idempotent command is not retried", func(t *testing.T) {
+		calls, _, err := simulateRetries(t, NewCreateRequest("subaccount/role", map[string]string{}), nil, http.StatusServiceUnavailable, http.StatusOK)
+
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+	t.Run("error path - gives up after max retries", func(t *testing.T) {
+		calls, _, err := simulateRetries(t, NewGetRequest("subaccount/role", map[string]string{}), nil, http.StatusGatewayTimeout)
+
+		assert.ErrorContains(t, err, "Command timed out. Please try again later.")
+		assert.Equal(t, 3, calls)
+	})
+	t.Run("error path - non-transient errors are not retried", func(t *testing.T) {
+		calls, _, err := simulateRetries(t, NewGetRequest("subaccount/role", map[string]string{}), nil, http.StatusForbidden)
+
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+	t.Run("error path - waiting for a retry is canceled with the context", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		srvUrl, _ := url.Parse(srv.URL)
+		uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl)
+		uut.RetryPolicy = RetryPolicy{MaxRetries: 1, MinBackoff: time.Hour, MaxBackoff: time.Hour}
+
+		ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := uut.Execute(ctx, NewGetRequest("subaccount/role", map[string]string{}))
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+// fakeCLIServer is a minimal stand-in for the CLI server, which issues a new session with every login and
+// only accepts commands within the latest session
+type fakeCLIServer struct {
+	sync.Mutex
+
+	validSessionId string
+	logins         int
+	commands       int
+	loginStatus    int
+	expireSessions bool
+	idTokens       []string
+}
+
+func (f *fakeCLIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	if strings.HasPrefix(r.URL.Path, "/login/") {
+		f.logins++
+
+		if f.loginStatus != 0 {
+			w.WriteHeader(f.loginStatus)
+			return
+		}
+
+		if strings.HasSuffix(r.URL.Path, "/idtoken") {
+			var loginReq IdTokenLoginRequest
+			_ = json.NewDecoder(r.Body).Decode(&loginReq)
+			f.idTokens = append(f.idTokens, loginReq.IdToken)
+		}
+
+		f.validSessionId = fmt.Sprintf("session-%d", f.logins)
+		w.Header().Set(HeaderCLISessionId, f.validSessionId)
+		fmt.Fprintf(w, `{"issuer": "accounts.sap.com","user":"john.doe","mail":"john.doe@test.com"}`)
+		return
+	}
+
+	f.commands++
+
+	if f.expireSessions || r.Header.Get(HeaderCLISessionId) != f.validSessionId {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set(HeaderCLIBackendStatus, "200")
+	fmt.Fprintf(w, "{}")
+}
+
+func (f *fakeCLIServer) expireSession() {
+	f.Lock()
+	defer f.Unlock()
+
+	f.validSessionId = "expired"
+}
+
+func TestV2Client_SessionRenewal(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) (*v2Client, *fakeCLIServer) {
+		fakeSrv := &fakeCLIServer{}
+		srv := httptest.NewServer(fakeSrv)
+		t.Cleanup(srv.Close)
+
+		srvUrl, _ := url.Parse(srv.URL)
+		uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl)
+
+		_, err := uut.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+		assert.NoError(t, err)
+
+		return uut, fakeSrv
+	}
+
+	t.Run("happy path - session is valid", func(t *testing.T) {
+		uut, fakeSrv := setup(t)
+
+		_, err := uut.Execute(context.TODO(), NewGetRequest("subaccount/role", map[string]string{}))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, fakeSrv.logins)
+		assert.Equal(t, 1, fakeSrv.commands)
+	})
+	t.Run("happy path - expired session is renewed and the command replayed", func(t *testing.T) {
+		uut, fakeSrv := setup(t)
+		fakeSrv.expireSession()
+
+		_, err := uut.Execute(context.TODO(), NewCreateRequest("subaccount/role", map[string]string{}))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, fakeSrv.logins)
+		assert.Equal(t, 2, fakeSrv.commands)
+		assert.Equal(t, "session-2", uut.session.SessionId)
+		assert.Equal(t, "subdomain", uut.session.GlobalAccountSubdomain)
+		assert.Equal(t, "john.doe", uut.GetLoggedInUser().Username)
+	})
+	t.Run("happy path - concurrent commands renew the session only once", func(t *testing.T) {
+		uut, fakeSrv := setup(t)
+		fakeSrv.expireSession()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				_, err := uut.Execute(context.TODO(), NewGetRequest("subaccount/role", map[string]string{}))
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 2, fakeSrv.logins)
+	})
+	t.Run("error path - command is replayed only once", func(t *testing.T) {
+		uut, fakeSrv := setup(t)
+		fakeSrv.expireSessions = true
+
+		_, err := uut.Execute(context.TODO(), NewGetRequest("subaccount/role", map[string]string{}))
+
+		assert.ErrorContains(t, err, "[Status: 401;")
+		assert.Equal(t, 2, fakeSrv.logins)
+		assert.Equal(t, 2, fakeSrv.commands)
+	})
+	t.Run("error path - renewing the session fails", func(t *testing.T) {
+		uut, fakeSrv := setup(t)
+		fakeSrv.expireSession()
+		fakeSrv.loginStatus = http.StatusUnauthorized
+
+		_, err := uut.Execute(context.TODO(), NewGetRequest("subaccount/role", map[string]string{}))
+
+		assert.ErrorContains(t, err, "unable to renew the expired session: Login failed. Check your credentials.")
+		assert.Equal(t, 2, fakeSrv.logins)
+		assert.Equal(t, 1, fakeSrv.commands)
+	})
+	t.Run("error path - no login flow to renew the session", func(t *testing.T) {
+		fakeSrv := &fakeCLIServer{validSessionId: "session-1"}
+		srv := httptest.NewServer(fakeSrv)
+		defer srv.Close()
+
+		srvUrl, _ := url.Parse(srv.URL)
+		uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl)
+		uut.session = &Session{SessionId: "expired"}
+
+		_, err := uut.Execute(context.TODO(), NewGetRequest("subaccount/role", map[string]string{}))
+
+		assert.ErrorContains(t, err, "[Status: 401;")
+		assert.Equal(t, 0, fakeSrv.logins)
+		assert.Equal(t, 1, fakeSrv.commands)
+	})
+}
+
+func TestV2Client_IdTokenFileLogin(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) (*v2Client, *fakeCLIServer, string) {
+		fakeSrv := &fakeCLIServer{}
+		srv := httptest.NewServer(fakeSrv)
+		t.Cleanup(srv.Close)
+
+		srvUrl, _ := url.Parse(srv.URL)
+
+		return NewV2ClientWithHttpClient(srv.Client(), srvUrl), fakeSrv, filepath.Join(t.TempDir(), "idtoken")
+	}
+
+	t.Run("happy path - id token is read from file", func(t *testing.T) {
+		uut, fakeSrv, idTokenFile := setup(t)
+		assert.NoError(t, os.WriteFile(idTokenFile, []byte("token-1\n"), 0600))
+
+		res, err := uut.IdTokenFileLogin(context.TODO(), NewIdTokenFileLoginRequest("subdomain", idTokenFile))
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, "john.doe", res.Username)
+			assert.Equal(t, []string{"token-1"}, fakeSrv.idTokens)
+			assert.Equal(t, "session-1", uut.session.SessionId)
+			assert.Equal(t, "subdomain", uut.session.GlobalAccountSubdomain)
+		}
+	})
+	t.Run("happy path - rotated id token is read again to renew the session", func(t *testing.T) {
+		uut, fakeSrv, idTokenFile := setup(t)
+		assert.NoError(t, os.WriteFile(idTokenFile, []byte("token-1"), 0600))
+
+		_, err := uut.IdTokenFileLogin(context.TODO(), NewIdTokenFileLoginRequest("subdomain", idTokenFile))
+		assert.NoError(t, err)
+
+		assert.NoError(t, os.WriteFile(idTokenFile, []byte("token-2"), 0600))
+		fakeSrv.expireSession()
+
+		_, err = uut.Execute(context.TODO(), NewGetRequest("subaccount/role", map[string]string{}))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"token-1", "token-2"}, fakeSrv.idTokens)
+		assert.Equal(t, "session-2", uut.session.SessionId)
+	})
+	t.Run("error path - id token file does not exist", func(t *testing.T) {
+		uut, fakeSrv, idTokenFile := setup(t)
+
+		_, err := uut.IdTokenFileLogin(context.TODO(), NewIdTokenFileLoginRequest("subdomain", idTokenFile))
+
+		assert.ErrorContains(t, err, "unable to read the id token file")
+		assert.Equal(t, 0, fakeSrv.logins)
+	})
+	t.Run("error path - id token file is empty", func(t *testing.T) {
+		uut, fakeSrv, idTokenFile := setup(t)
+		assert.NoError(t, os.WriteFile(idTokenFile, []byte(" \n"), 0600))
+
+		_, err := uut.IdTokenFileLogin(context.TODO(), NewIdTokenFileLoginRequest("subdomain", idTokenFile))
+
+		assert.ErrorContains(t, err, "is empty")
+		assert.Equal(t, 0, fakeSrv.logins)
+	})
+	t.Run("error path - id token file removed before the session is renewed", func(t *testing.T) {
+		uut, fakeSrv, idTokenFile := setup(t)
+		assert.NoError(t, os.WriteFile(idTokenFile, []byte("token-1"), 0600))
+
+		_, err := uut.IdTokenFileLogin(context.TODO(), NewIdTokenFileLoginRequest("subdomain", idTokenFile))
+		assert.NoError(t, err)
+
+		assert.NoError(t, os.Remove(idTokenFile))
+		fakeSrv.expireSession()
+
+		_, err = uut.Execute(context.TODO(), NewGetRequest("subaccount/role", map[string]string{}))
+
+		assert.ErrorContains(t, err, "unable to renew the expired session: unable to read the id token file")
+		assert.Equal(t, 1, fakeSrv.logins)
+	})
+}
+
+func TestV2Client_SessionCache(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) (*fakeCLIServer, func() *v2Client) {
+		fakeSrv := &fakeCLIServer{}
+		srv := httptest.NewServer(fakeSrv)
+		t.Cleanup(srv.Close)
+
+		srvUrl, _ := url.Parse(srv.URL)
+		cache := NewSessionCache(t.TempDir())
+
+		return fakeSrv, func() *v2Client {
+			client := NewV2ClientWithHttpClient(srv.Client(), srvUrl)
+			client.SessionCache = cache
+			return client
+		}
+	}
+
+	t.Run("happy path - cached session is reused", func(t *testing.T) {
+		fakeSrv, newClient := setup(t)
+
+		_, err := newClient().Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+		assert.NoError(t, err)
+
+		uut := newClient()
+		res, err := uut.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, "john.doe", res.Username)
+			assert.Equal(t, 1, fakeSrv.logins)
+			assert.Equal(t, 1, fakeSrv.commands)
+			assert.Equal(t, "session-1", uut.session.SessionId)
+			assert.Equal(t, "subdomain", uut.GetGlobalAccountSubdomain())
+		}
+	})
+	t.Run("happy path - invalid cached session is replaced", func(t *testing.T) {
+		fakeSrv, newClient := setup(t)
+
+		_, err := newClient().Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+		assert.NoError(t, err)
+		fakeSrv.expireSession()
+
+		_, err = newClient().Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+		assert.NoError(t, err)
+
+		uut := newClient()
+		_, err = uut.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, fakeSrv.logins)
+		assert.Equal(t, "session-2", uut.session.SessionId)
+	})
+	t.Run("happy path - renewed session is cached", func(t *testing.T) {
+		fakeSrv, newClient := setup(t)
+
+		client := newClient()
+		_, err := client.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+		assert.NoError(t, err)
+		fakeSrv.expireSession()
+
+		_, err = client.Execute(context.TODO(), NewGetRequest("subaccount/role", map[string]string{}))
+		assert.NoError(t, err)
+
+		uut := newClient()
+		_, err = uut.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, fakeSrv.logins)
+		assert.Equal(t, "session-2", uut.session.SessionId)
+	})
+	t.Run("happy path - sessions of different users are kept apart", func(t *testing.T) {
+		fakeSrv, newClient := setup(t)
+
+		_, err := newClient().Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+		assert.NoError(t, err)
+
+		_, err = newClient().Login(context.TODO(), NewLoginRequest("subdomain", "jane.doe", "pass"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, fakeSrv.logins)
+	})
+	t.Run("happy path - id token logins are not cached", func(t *testing.T) {
+		fakeSrv, newClient := setup(t)
+
+		_, err := newClient().IdTokenLogin(context.TODO(), NewIdTokenLoginRequest("subdomain", "token"))
+		assert.NoError(t, err)
+
+		_, err = newClient().IdTokenLogin(context.TODO(), NewIdTokenLoginRequest("subdomain", "token"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, fakeSrv.logins)
+		assert.Equal(t, 0, fakeSrv.commands)
 	})
 }
 
+func TestV2Client_RequestLimits(t *testing.T) {
+	t.Parallel()
+
+	// execute sends the given commands in parallel and returns the maximum number of requests the server handled at the same time
+	execute := func(t *testing.T, limits RequestLimits, cmdReqs ...*CommandRequest) int32 {
+		var inFlight, maxInFlight atomic.Int32
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+
+			for {
+				observed := maxInFlight.Load()
+				if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			w.Header().Set(HeaderCLIBackendStatus, "200")
+			fmt.Fprintf(w, "{}")
+		}))
+		defer srv.Close()
+
+		srvUrl, _ := url.Parse(srv.URL)
+		uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl)
+		uut.session = &Session{SessionId: "session-1"}
+		uut.SetRequestLimits(limits)
+
+		var wg sync.WaitGroup
+		for _, cmdReq := range cmdReqs {
+			wg.Add(1)
+			go func(cmdReq *CommandRequest) {
+				defer wg.Done()
+
+				_, err := uut.Execute(context.TODO(), cmdReq)
+				assert.NoError(t, err)
+			}(cmdReq)
+		}
+		wg.Wait()
+
+		return maxInFlight.Load()
+	}
+
+	t.Run("happy path - requests are sent concurrently", func(t *testing.T) {
+		maxInFlight := execute(t, RequestLimits{},
+			NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "subaccount-1"}),
+			NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "subaccount-1"}),
+			NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "subaccount-1"}),
+		)
+
+		assert.Equal(t, int32(3), maxInFlight)
+	})
+	t.Run("happy path - concurrent requests are limited", func(t *testing.T) {
+		maxInFlight := execute(t, RequestLimits{MaxConcurrentRequests: 2},
+			NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "subaccount-1"}),
+			NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "subaccount-2"}),
+			NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "subaccount-3"}),
+			NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "subaccount-4"}),
+		)
+
+		assert.Equal(t, int32(2), maxInFlight)
+	})
+	t.Run("happy path - modifications of the same subaccount are serialized", func(t *testing.T) {
+		maxInFlight := execute(t, RequestLimits{SerializeSubaccountRequests: true},
+			NewCreateRequest("security/role-collection", map[string]string{"subaccount": "subaccount-1"}),
+			NewUpdateRequest("accounts/subaccount-entitlement", map[string]string{"subaccount": "subaccount-1"}),
+			NewDeleteRequest("security/role-collection", map[string]string{"subaccount": "subaccount-1"}),
+		)
+
+		assert.Equal(t, int32(1), maxInFlight)
+	})
+	t.Run("happy path - updates with struct inputs are serialized", func(t *testing.T) {
+		maxInFlight := execute(t, RequestLimits{SerializeSubaccountRequests: true},
+			NewUpdateRequest("accounts/subaccount", &SubaccountUpdateInput{SubaccountId: "subaccount-1"}),
+			NewUpdateRequest("accounts/subaccount", &SubaccountUpdateInput{SubaccountId: "subaccount-1"}),
+		)
+
+		assert.Equal(t, int32(1), maxInFlight)
+	})
+	t.Run("happy path - reads of the same subaccount are not serialized", func(t *testing.T) {
+		maxInFlight := execute(t, RequestLimits{SerializeSubaccountRequests: true},
+			NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "subaccount-1"}),
+			NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "subaccount-1"}),
+		)
+
+		assert.Equal(t, int32(2), maxInFlight)
+	})
+}
+
+func TestV2Client_NoRequestLimitsByDefault(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, maxInFlight atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		w.Header().Set(HeaderCLIBackendStatus, "200")
+		fmt.Fprintf(w, "{}")
+	}))
+	defer srv.Close()
+
+	srvUrl, _ := url.Parse(srv.URL)
+	uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl)
+	uut.session = &Session{SessionId: "session-1"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := uut.Execute(context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "subaccount-1"}))
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// without configured limits, the requests are not throttled
+	assert.Nil(t, uut.limiter)
+	assert.Equal(t, int32(3), maxInFlight.Load())
+}
+
 type v2SimulationConfig struct {
 	// initialize the client session prior to the test simulation
 	initSession *Session
@@ -27,6 +27,14 @@ func NewIdTokenLoginRequest(globalaccountSubdomain string, idToken string) *IdTo
 	}
 }
 
+// NewIdTokenFileLoginRequest returns a new IdTokenFileLoginRequest for the id token stored in the given file.
+func NewIdTokenFileLoginRequest(globalaccountSubdomain string, idTokenFile string) *IdTokenFileLoginRequest {
+	return &IdTokenFileLoginRequest{
+		GlobalAccountSubdomain: globalaccountSubdomain,
+		IdTokenFile:            idTokenFile,
+	}
+}
+
 type LoginRequest struct {
 	IdentityProvider       string `json:"customIdp"`
 	GlobalAccountSubdomain string `json:"subdomain"`
@@ -39,6 +47,13 @@ type IdTokenLoginRequest struct {
 	IdToken                string `json:"idToken"`
 }
 
+// IdTokenFileLoginRequest refers to a file containing an id token, e.g. an OIDC token issued to a CI pipeline.
+// The file is read on every login, so that rotated tokens are picked up when the session gets renewed.
+type IdTokenFileLoginRequest struct {
+	GlobalAccountSubdomain string
+	IdTokenFile            string
+}
+
 type PasscodeLoginRequest struct {
 	GlobalAccountSubdomain string
 	IdentityProvider       string
@@ -67,6 +82,9 @@ func NewCommandRequest(action Action, command string, args any) *CommandRequest
 type CommandOptions struct {
 	GoodState        int
 	KnownErrorStates map[int]string
+
+	// Retriable opts commands into the client's retry policy, which otherwise only applies to idempotent actions (get/list)
+	Retriable bool
 }
 
 type Action string
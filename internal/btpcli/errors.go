@@ -0,0 +1,37 @@
+package btpcli
+
+import (
+	"fmt"
+)
+
+// BackendError is returned in case the CLI server or the backend behind it report a failure. Use errors.As to
+// access the details, e.g. to match on the status reported by the backend instead of the error message.
+type BackendError struct {
+	// Command and Action of the failed command; both are empty for failed logins
+	Command string
+	Action  Action
+
+	// StatusCode is the HTTP status code of the CLI server response
+	StatusCode int
+
+	// BackendStatusCode is the status code reported by the backend via the X-Cpcli-Backend-Status header.
+	// It is zero if the CLI server itself rejected the request.
+	BackendStatusCode int
+
+	// BackendMessage is the error message reported by the backend, if any
+	BackendMessage string
+
+	// CorrelationID identifies the request in the logs of the CLI server and the backend
+	CorrelationID string
+
+	// Message is the human-readable description of the failure
+	Message string
+}
+
+func (e *BackendError) Error() string {
+	if e.BackendStatusCode > 0 {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s [Status: %d; Correlation ID: %s]", e.Message, e.StatusCode, e.CorrelationID)
+}
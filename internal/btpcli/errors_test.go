@@ -0,0 +1,42 @@
+package btpcli
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackendError(t *testing.T) {
+	t.Parallel()
+	t.Run("error reported by the CLI server", func(t *testing.T) {
+		uut := &BackendError{
+			StatusCode:    http.StatusGatewayTimeout,
+			CorrelationID: "correlation-id",
+			Message:       "Command timed out. Please try again later.",
+		}
+
+		assert.EqualError(t, uut, "Command timed out. Please try again later. [Status: 504; Correlation ID: correlation-id]")
+	})
+	t.Run("error reported by the backend", func(t *testing.T) {
+		uut := &BackendError{
+			StatusCode:        http.StatusOK,
+			BackendStatusCode: http.StatusNotFound,
+			BackendMessage:    "subaccount not found",
+			CorrelationID:     "correlation-id",
+			Message:           "subaccount not found",
+		}
+
+		assert.EqualError(t, uut, "subaccount not found")
+	})
+	t.Run("can be unwrapped with errors.As", func(t *testing.T) {
+		err := fmt.Errorf("wrapped: %w", &BackendError{BackendStatusCode: http.StatusConflict})
+
+		var backendErr *BackendError
+		if assert.True(t, errors.As(err, &backendErr)) {
+			assert.Equal(t, http.StatusConflict, backendErr.BackendStatusCode)
+		}
+	})
+}
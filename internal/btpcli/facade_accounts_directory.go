@@ -34,6 +34,7 @@ type DirectoryCreateInput struct {
 	Labels        map[string][]string `btpcli:"labels"`
 	Globalaccount string              `btpcli:"globalAccount"`
 	Features      []string            `btpcli:"directoryFeatures"`
+	Admins        []string            `btpcli:"directoryAdmins,json,omitempty"`
 }
 
 type DirectoryUpdateInput struct {
@@ -44,6 +45,14 @@ type DirectoryUpdateInput struct {
 	Labels        map[string][]string `btpcli:"labels"`
 }
 
+type DirectoryEnableInput struct {
+	DirectoryId   string   `btpcli:"directoryID"`
+	Globalaccount string   `btpcli:"globalAccount"`
+	Features      []string `btpcli:"directoryFeatures"`
+	Subdomain     *string  `btpcli:"subdomain"`
+	Admins        []string `btpcli:"directoryAdmins,json,omitempty"`
+}
+
 func (f *accountsDirectoryFacade) Create(ctx context.Context, args *DirectoryCreateInput) (cis.DirectoryResponseObject, CommandResponse, error) {
 	args.Globalaccount = f.cliClient.GetGlobalAccountSubdomain()
 
@@ -68,6 +77,19 @@ func (f *accountsDirectoryFacade) Update(ctx context.Context, args *DirectoryUpd
 	return doExecute[cis.DirectoryResponseObject](f.cliClient, ctx, NewUpdateRequest(f.getCommand(), params))
 }
 
+// Enable sets the features of the directory. Features which are not handed over are disabled.
+func (f *accountsDirectoryFacade) Enable(ctx context.Context, args *DirectoryEnableInput) (cis.DirectoryResponseObject, CommandResponse, error) {
+	args.Globalaccount = f.cliClient.GetGlobalAccountSubdomain()
+
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return cis.DirectoryResponseObject{}, CommandResponse{}, err
+	}
+
+	return doExecute[cis.DirectoryResponseObject](f.cliClient, ctx, NewEnableRequest(f.getCommand(), params))
+}
+
 func (f *accountsDirectoryFacade) Delete(ctx context.Context, directoryId string) (cis.DirectoryResponseObject, CommandResponse, error) {
 	return doExecute[cis.DirectoryResponseObject](f.cliClient, ctx, NewDeleteRequest(f.getCommand(), map[string]string{
 		"globalAccount": f.cliClient.GetGlobalAccountSubdomain(),
@@ -69,12 +69,13 @@ func TestAccountsDirectoryFacade_Create(t *testing.T) {
 			srvCalled = true
 
 			assertCall(t, r, command, ActionCreate, map[string]string{
-				"globalAccount": globalAccount,
-				"displayName":   displayName,
-				"description":   description,
-				"subdomain":     subdomain,
-				"parentID":      parentId,
-				"labels":        "{}",
+				"globalAccount":   globalAccount,
+				"displayName":     displayName,
+				"description":     description,
+				"subdomain":       subdomain,
+				"parentID":        parentId,
+				"labels":          "{}",
+				"directoryAdmins": `["jane.doe@test.com"]`,
 			})
 		}))
 		defer srv.Close()
@@ -85,6 +86,7 @@ func TestAccountsDirectoryFacade_Create(t *testing.T) {
 			Subdomain:   &subdomain,
 			ParentID:    &parentId,
 			Labels:      map[string][]string{},
+			Admins:      []string{"jane.doe@test.com"},
 		})
 
 		if assert.True(t, srvCalled) && assert.NoError(t, err) {
@@ -131,6 +133,66 @@ func TestAccountsDirectoryFacade_Update(t *testing.T) {
 	})
 }
 
+func TestAccountsDirectoryFacade_Enable(t *testing.T) {
+	command := "accounts/directory"
+	globalAccount := "795b53bb-a3f0-4769-adf0-26173282a975"
+
+	directoryId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	subdomain := "my-subdomain"
+
+	t.Run("constructs the CLI params correctly - minimal", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionEnable, map[string]string{
+				"globalAccount":     globalAccount,
+				"directoryID":       directoryId,
+				"directoryFeatures": "DEFAULT,ENTITLEMENTS",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Directory.Enable(context.TODO(), &DirectoryEnableInput{
+			DirectoryId: directoryId,
+			Features:    []string{"DEFAULT", "ENTITLEMENTS"},
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+
+	t.Run("constructs the CLI params correctly - full", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionEnable, map[string]string{
+				"globalAccount":     globalAccount,
+				"directoryID":       directoryId,
+				"directoryFeatures": "DEFAULT,ENTITLEMENTS,AUTHORIZATIONS",
+				"subdomain":         subdomain,
+				"directoryAdmins":   `["jane.doe@test.com"]`,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Directory.Enable(context.TODO(), &DirectoryEnableInput{
+			DirectoryId: directoryId,
+			Features:    []string{"DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"},
+			Subdomain:   &subdomain,
+			Admins:      []string{"jane.doe@test.com"},
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
 func TestAccountsDirectoryFacade_Delete(t *testing.T) {
 	command := "accounts/directory"
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis"
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
 )
 
 func newAccountsGlobalAccountFacade(cliClient *v2Client) accountsGlobalAccountFacade {
@@ -30,3 +31,22 @@ func (f *accountsGlobalAccountFacade) GetWithHierarchy(ctx context.Context) (cis
 		"showHierarchy": "true",
 	}))
 }
+
+type GlobalAccountUpdateInput struct {
+	Globalaccount string  `btpcli:"globalAccount"`
+	DisplayName   *string `btpcli:"displayName"`
+	Description   *string `btpcli:"description"`
+	Usage         *string `btpcli:"useFor"`
+}
+
+func (f *accountsGlobalAccountFacade) Update(ctx context.Context, args *GlobalAccountUpdateInput) (cis.GlobalAccountResponseObject, CommandResponse, error) {
+	args.Globalaccount = f.cliClient.GetGlobalAccountSubdomain()
+
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return cis.GlobalAccountResponseObject{}, CommandResponse{}, err
+	}
+
+	return doExecute[cis.GlobalAccountResponseObject](f.cliClient, ctx, NewUpdateRequest(f.getCommand(), params))
+}
@@ -30,3 +30,59 @@ func TestAccountsGlobalAccountFacade_Get(t *testing.T) {
 		}
 	})
 }
+
+func TestAccountsGlobalAccountFacade_Update(t *testing.T) {
+	command := "accounts/global-account"
+
+	displayName := "my-global-account"
+	description := "a description"
+	usage := "Development"
+
+	t.Run("constructs the CLI params correctly - minimal", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"globalAccount": "795b53bb-a3f0-4769-adf0-26173282a975",
+				"displayName":   displayName,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.GlobalAccount.Update(context.TODO(), &GlobalAccountUpdateInput{
+			DisplayName: &displayName,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+
+	t.Run("constructs the CLI params correctly - full", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"globalAccount": "795b53bb-a3f0-4769-adf0-26173282a975",
+				"displayName":   displayName,
+				"description":   description,
+				"useFor":        usage,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.GlobalAccount.Update(context.TODO(), &GlobalAccountUpdateInput{
+			DisplayName: &displayName,
+			Description: &description,
+			Usage:       &usage,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package btpcli
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis"
 )
@@ -31,3 +32,41 @@ func (f *accountsLabelFacade) ListByDirectory(ctx context.Context, directoryId s
 		"directoryID":   directoryId,
 	}))
 }
+
+// UpdateBySubaccount replaces all labels of the subaccount with the given ones
+func (f *accountsLabelFacade) UpdateBySubaccount(ctx context.Context, subaccountId string, labels map[string][]string) (cis.LabelsResponseObject, CommandResponse, error) {
+	jsonLabels, err := encodeLabels(labels)
+	if err != nil {
+		return cis.LabelsResponseObject{}, CommandResponse{}, err
+	}
+
+	return doExecute[cis.LabelsResponseObject](f.cliClient, ctx, NewUpdateRequest(f.getCommand(), map[string]string{
+		"globalAccount": f.cliClient.GetGlobalAccountSubdomain(),
+		"subaccountID":  subaccountId,
+		"labels":        jsonLabels,
+	}))
+}
+
+// UpdateByDirectory replaces all labels of the directory with the given ones
+func (f *accountsLabelFacade) UpdateByDirectory(ctx context.Context, directoryId string, labels map[string][]string) (cis.LabelsResponseObject, CommandResponse, error) {
+	jsonLabels, err := encodeLabels(labels)
+	if err != nil {
+		return cis.LabelsResponseObject{}, CommandResponse{}, err
+	}
+
+	return doExecute[cis.LabelsResponseObject](f.cliClient, ctx, NewUpdateRequest(f.getCommand(), map[string]string{
+		"globalAccount": f.cliClient.GetGlobalAccountSubdomain(),
+		"directoryID":   directoryId,
+		"labels":        jsonLabels,
+	}))
+}
+
+func encodeLabels(labels map[string][]string) (string, error) {
+	if labels == nil {
+		// no labels must be sent as an empty object to remove all existing labels
+		labels = map[string][]string{}
+	}
+
+	jsonLabels, err := json.Marshal(labels)
+	return string(jsonLabels), err
+}
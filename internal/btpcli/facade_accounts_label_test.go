@@ -61,3 +61,107 @@ func TestAccountsLabelFacade_ListByDirectory(t *testing.T) {
 		}
 	})
 }
+
+func TestAccountsLabelFacade_UpdateBySubaccount(t *testing.T) {
+	command := "accounts/label"
+
+	globalAccountId := "795b53bb-a3f0-4769-adf0-26173282a975"
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"globalAccount": globalAccountId,
+				"subaccountID":  subaccountId,
+				"labels":        `{"Cost Center":["19700626"],"EMEA":[]}`,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Label.UpdateBySubaccount(context.TODO(), subaccountId, map[string][]string{
+			"Cost Center": {"19700626"},
+			"EMEA":        {},
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+
+	t.Run("removes all labels", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"globalAccount": globalAccountId,
+				"subaccountID":  subaccountId,
+				"labels":        "{}",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Label.UpdateBySubaccount(context.TODO(), subaccountId, nil)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestAccountsLabelFacade_UpdateByDirectory(t *testing.T) {
+	command := "accounts/label"
+
+	globalAccountId := "795b53bb-a3f0-4769-adf0-26173282a975"
+	directoryId := "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"globalAccount": globalAccountId,
+				"directoryID":   directoryId,
+				"labels":        `{"Cost Center":["19700626"],"EMEA":[]}`,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Label.UpdateByDirectory(context.TODO(), directoryId, map[string][]string{
+			"Cost Center": {"19700626"},
+			"EMEA":        {},
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+
+	t.Run("removes all labels", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"globalAccount": globalAccountId,
+				"directoryID":   directoryId,
+				"labels":        "{}",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Label.UpdateByDirectory(context.TODO(), directoryId, nil)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
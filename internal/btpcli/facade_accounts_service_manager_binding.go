@@ -0,0 +1,47 @@
+package btpcli
+
+import (
+	"context"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis"
+)
+
+func newAccountsServiceManagerBindingFacade(cliClient *v2Client) accountsServiceManagerBindingFacade {
+	return accountsServiceManagerBindingFacade{cliClient: cliClient}
+}
+
+type accountsServiceManagerBindingFacade struct {
+	cliClient *v2Client
+}
+
+func (f *accountsServiceManagerBindingFacade) getCommand() string {
+	return "accounts/service-manager-binding"
+}
+
+func (f *accountsServiceManagerBindingFacade) List(ctx context.Context, subaccountId string) ([]cis.ServiceManagementBindingResponseObject, CommandResponse, error) {
+	return doExecute[[]cis.ServiceManagementBindingResponseObject](f.cliClient, ctx, NewListRequest(f.getCommand(), map[string]string{
+		"subaccount": subaccountId,
+	}))
+}
+
+func (f *accountsServiceManagerBindingFacade) Get(ctx context.Context, subaccountId string, name string) (cis.ServiceManagementBindingResponseObject, CommandResponse, error) {
+	return doExecute[cis.ServiceManagementBindingResponseObject](f.cliClient, ctx, NewGetRequest(f.getCommand(), map[string]string{
+		"subaccount": subaccountId,
+		"name":       name,
+	}))
+}
+
+func (f *accountsServiceManagerBindingFacade) Create(ctx context.Context, subaccountId string, name string) (cis.ServiceManagementBindingResponseObject, CommandResponse, error) {
+	return doExecute[cis.ServiceManagementBindingResponseObject](f.cliClient, ctx, NewCreateRequest(f.getCommand(), map[string]string{
+		"subaccount": subaccountId,
+		"name":       name,
+	}))
+}
+
+func (f *accountsServiceManagerBindingFacade) Delete(ctx context.Context, subaccountId string, name string) (cis.ServiceManagementBindingResponseObject, CommandResponse, error) {
+	return doExecute[cis.ServiceManagementBindingResponseObject](f.cliClient, ctx, NewDeleteRequest(f.getCommand(), map[string]string{
+		"subaccount": subaccountId,
+		"name":       name,
+		"confirm":    "true",
+	}))
+}
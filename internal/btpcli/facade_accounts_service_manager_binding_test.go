@@ -0,0 +1,116 @@
+package btpcli
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountsServiceManagerBindingFacade_List(t *testing.T) {
+	command := "accounts/service-manager-binding"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionList, map[string]string{
+				"subaccount": subaccountId,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.ServiceManagerBinding.List(context.TODO(), subaccountId)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestAccountsServiceManagerBindingFacade_Get(t *testing.T) {
+	command := "accounts/service-manager-binding"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	name := "my-binding"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionGet, map[string]string{
+				"subaccount": subaccountId,
+				"name":       name,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.ServiceManagerBinding.Get(context.TODO(), subaccountId, name)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestAccountsServiceManagerBindingFacade_Create(t *testing.T) {
+	command := "accounts/service-manager-binding"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	name := "my-binding"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionCreate, map[string]string{
+				"subaccount": subaccountId,
+				"name":       name,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.ServiceManagerBinding.Create(context.TODO(), subaccountId, name)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestAccountsServiceManagerBindingFacade_Delete(t *testing.T) {
+	command := "accounts/service-manager-binding"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	name := "my-binding"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionDelete, map[string]string{
+				"subaccount": subaccountId,
+				"name":       name,
+				"confirm":    "true",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.ServiceManagerBinding.Delete(context.TODO(), subaccountId, name)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
@@ -50,7 +50,7 @@ type SubaccountCreateInput struct { // TODO support all options
 	Subdomain         string              `btpcli:"subdomain"`
 	UsedForProduction string              `btpcli:"usedForProduction"`
 	Globalaccount     string              `btpcli:"globalAccount"`
-	//SubaccountAdmins  string `json:"subaccountAdmins"`
+	SubaccountAdmins  []string            `btpcli:"subaccountAdmins,json,omitempty"`
 }
 
 type SubaccountUpdateInput struct {
@@ -108,6 +108,22 @@ func (f *accountsSubaccountFacade) Delete(ctx context.Context, subaccountId stri
 	return doExecute[cis.SubaccountResponseObject](f.cliClient, ctx, NewDeleteRequest(f.getCommand(), requestArgs))
 }
 
+// Move moves the subaccount to the given directory. If no directory is given, the subaccount is moved directly into the global account.
+func (f *accountsSubaccountFacade) Move(ctx context.Context, subaccountId string, directoryId string) (cis.SubaccountResponseObject, CommandResponse, error) {
+	requestArgs := map[string]string{
+		"globalAccount": f.cliClient.GetGlobalAccountSubdomain(),
+		"subaccount":    subaccountId,
+	}
+
+	if len(directoryId) > 0 {
+		requestArgs["toDirectory"] = directoryId
+	} else {
+		requestArgs["toGlobalAccount"] = f.cliClient.GetGlobalAccountSubdomain()
+	}
+
+	return doExecute[cis.SubaccountResponseObject](f.cliClient, ctx, NewMoveRequest(f.getCommand(), requestArgs))
+}
+
 func (f *accountsSubaccountFacade) Subscribe(ctx context.Context, subaccountId string, appName string, planName string, parameters string) (saas_manager_service.SubscriptionAssignmentResponseObject, CommandResponse, error) {
 	commandOptions := map[string]string{
 		"subaccount":         subaccountId,
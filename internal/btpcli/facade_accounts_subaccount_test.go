@@ -113,6 +113,36 @@ func TestAccountsSubaccountFacade_Create(t *testing.T) {
 			assert.Equal(t, 200, res.StatusCode)
 		}
 	})
+
+	t.Run("constructs the CLI params correctly (with admins)", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionCreate, map[string]string{
+				"displayName":      displayName,
+				"subdomain":        subdomain,
+				"region":           region,
+				"betaEnabled":      "false",
+				"globalAccount":    globalAccount,
+				"subaccountAdmins": `["jane.doe@test.com","john.doe@test.com"]`,
+			})
+
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Subaccount.Create(context.TODO(), &SubaccountCreateInput{
+			DisplayName:      displayName,
+			Subdomain:        subdomain,
+			Region:           region,
+			SubaccountAdmins: []string{"jane.doe@test.com", "john.doe@test.com"},
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
 }
 
 func TestAccountsSubaccountFacade_Update(t *testing.T) {
@@ -207,6 +237,55 @@ func TestAccountsSubaccountFacade_Delete(t *testing.T) {
 	})
 }
 
+func TestAccountsSubaccountFacade_Move(t *testing.T) {
+	command := "accounts/subaccount"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	directoryId := "7bb64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+
+	t.Run("constructs the CLI params correctly (to directory)", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionMove, map[string]string{
+				"globalAccount": "795b53bb-a3f0-4769-adf0-26173282a975",
+				"subaccount":    subaccountId,
+				"toDirectory":   directoryId,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Subaccount.Move(context.TODO(), subaccountId, directoryId)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+
+	t.Run("constructs the CLI params correctly (to global account)", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionMove, map[string]string{
+				"globalAccount":   "795b53bb-a3f0-4769-adf0-26173282a975",
+				"subaccount":      subaccountId,
+				"toGlobalAccount": "795b53bb-a3f0-4769-adf0-26173282a975",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Subaccount.Move(context.TODO(), subaccountId, "")
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
 func TestAccountsSubaccountFacade_Subscribe(t *testing.T) {
 	command := "accounts/subaccount"
 
@@ -42,3 +42,21 @@ func (f *accountsSubscriptionFacade) Get(ctx context.Context, subaccountId strin
 
 	return doExecute[saas_manager_service.EntitledApplicationsResponseObject](f.cliClient, ctx, NewGetRequest(f.getCommand(), params))
 }
+
+// Update changes the plan and/or the parameters of an existing subscription. Empty values are left unchanged.
+func (f *accountsSubscriptionFacade) Update(ctx context.Context, subaccountId string, appName string, planName string, parameters string) (saas_manager_service.SubscriptionAssignmentResponseObject, CommandResponse, error) {
+	params := map[string]string{
+		"subaccount": subaccountId,
+		"appName":    appName,
+	}
+
+	if len(planName) > 0 {
+		params["planName"] = planName
+	}
+
+	if len(parameters) > 0 {
+		params["subscriptionParams"] = parameters
+	}
+
+	return doExecute[saas_manager_service.SubscriptionAssignmentResponseObject](f.cliClient, ctx, NewUpdateRequest(f.getCommand(), params))
+}
@@ -82,3 +82,51 @@ func TestAccountsSubscriptionFacade_Get(t *testing.T) {
 		}
 	})
 }
+
+func TestAccountsSubscriptionFacade_Update(t *testing.T) {
+	command := "accounts/subscription"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	appName := "content-agent-ui"
+
+	t.Run("constructs the CLI params correctly - plan update", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"subaccount": subaccountId,
+				"appName":    appName,
+				"planName":   "standard",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Subscription.Update(context.TODO(), subaccountId, appName, "standard", "")
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+	t.Run("constructs the CLI params correctly - parameters update", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"subaccount":         subaccountId,
+				"appName":            appName,
+				"subscriptionParams": `{"a":"b"}`,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Subscription.Update(context.TODO(), subaccountId, appName, "", `{"a":"b"}`)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package btpcli
 
 func newSecurityFacade(cliClient *v2Client) securityFacade {
 	return securityFacade{
+		ApiCredential:  newSecurityApiCredentialFacade(cliClient),
 		App:            newSecurityAppFacade(cliClient),
 		Role:           newSecurityRoleFacade(cliClient),
 		RoleCollection: newSecurityRoleCollectionFacade(cliClient),
@@ -12,6 +13,7 @@ func newSecurityFacade(cliClient *v2Client) securityFacade {
 }
 
 type securityFacade struct {
+	ApiCredential  securityApiCredentialFacade
 	App            securityAppFacade
 	Role           securityRoleFacade
 	RoleCollection securityRoleCollectionFacade
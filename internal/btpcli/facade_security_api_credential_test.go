@@ -0,0 +1,121 @@
+package btpcli
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityApiCredentialFacade_GetBySubaccount(t *testing.T) {
+	command := "security/api-credential"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	name := "my-api-credential"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionGet, map[string]string{
+				"subaccount": subaccountId,
+				"name":       name,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.ApiCredential.GetBySubaccount(context.TODO(), subaccountId, name)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestSecurityApiCredentialFacade_CreateBySubaccount(t *testing.T) {
+	command := "security/api-credential"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	name := "my-api-credential"
+	certificate := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"
+
+	t.Run("constructs the CLI params correctly - secret", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionCreate, map[string]string{
+				"subaccount": subaccountId,
+				"name":       name,
+				"readOnly":   "true",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.ApiCredential.CreateBySubaccount(context.TODO(), SubaccountApiCredentialCreateInput{
+			Subaccount: subaccountId,
+			Name:       name,
+			ReadOnly:   true,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+	t.Run("constructs the CLI params correctly - certificate", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionCreate, map[string]string{
+				"subaccount":  subaccountId,
+				"name":        name,
+				"certificate": certificate,
+				"readOnly":    "false",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.ApiCredential.CreateBySubaccount(context.TODO(), SubaccountApiCredentialCreateInput{
+			Subaccount:  subaccountId,
+			Name:        name,
+			Certificate: certificate,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestSecurityApiCredentialFacade_DeleteBySubaccount(t *testing.T) {
+	command := "security/api-credential"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	name := "my-api-credential"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionDelete, map[string]string{
+				"subaccount": subaccountId,
+				"name":       name,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.ApiCredential.DeleteBySubaccount(context.TODO(), subaccountId, name)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
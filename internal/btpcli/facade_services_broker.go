@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
 )
 
 func newServicesBrokerFacade(cliClient *v2Client) servicesBrokerFacade {
@@ -47,3 +48,74 @@ func (f servicesBrokerFacade) GetByName(ctx context.Context, subaccountId string
 		"name":       brokerName,
 	}))
 }
+
+type ServiceBrokerRegisterInput struct {
+	Subaccount  string              `btpcli:"subaccount"`
+	Name        string              `btpcli:"name"`
+	Description string              `btpcli:"description"`
+	URL         string              `btpcli:"url"`
+	User        string              `btpcli:"user"`
+	Password    string              `btpcli:"password"`
+	Labels      map[string][]string `btpcli:"labels"`
+}
+
+func (f servicesBrokerFacade) Register(ctx context.Context, args *ServiceBrokerRegisterInput) (servicemanager.ServiceBrokerResponseObject, CommandResponse, error) {
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return servicemanager.ServiceBrokerResponseObject{}, CommandResponse{}, err
+	}
+
+	brokerResponseObject, cmdRes, err := doExecute[servicemanager.ServiceBrokerResponseObject](f.cliClient, ctx, NewRegisterRequest(f.getCommand(), params))
+
+	if err != nil || len(brokerResponseObject.Id) > 0 {
+		return brokerResponseObject, cmdRes, err
+	}
+
+	// The registration may be processed asynchronously, in which case the broker is not part of the response
+	return f.GetByName(ctx, args.Subaccount, args.Name)
+}
+
+type ServiceBrokerUpdateInput struct {
+	Id          string `btpcli:"id"`
+	Subaccount  string `btpcli:"subaccount"`
+	NewName     string `btpcli:"newName"`
+	Description string `btpcli:"description"`
+	URL         string `btpcli:"url"`
+	User        string `btpcli:"user"`
+	Password    string `btpcli:"password"`
+	LabelsPlan  map[string][]string
+	LabelsState map[string][]string
+}
+
+func (f servicesBrokerFacade) Update(ctx context.Context, args *ServiceBrokerUpdateInput) (servicemanager.ServiceBrokerResponseObject, CommandResponse, error) {
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return servicemanager.ServiceBrokerResponseObject{}, CommandResponse{}, err
+	}
+
+	if computedLabels := computeLabelParam(args.LabelsPlan, args.LabelsState); computedLabels != "" {
+		// Parameter must only be added to call if non-empty
+		params["labels"] = computedLabels
+	}
+
+	// The CLI server does not necessarily return the updated broker, so it is read again to get a consistent response
+	res, err := f.cliClient.Execute(ctx, NewUpdateRequest(f.getCommand(), params))
+
+	if err != nil {
+		return servicemanager.ServiceBrokerResponseObject{}, res, err
+	}
+
+	res.Body.Close()
+
+	return f.GetById(ctx, args.Subaccount, args.Id)
+}
+
+func (f servicesBrokerFacade) Unregister(ctx context.Context, subaccountId string, brokerId string) (CommandResponse, error) {
+	return f.cliClient.Execute(ctx, NewUnregisterRequest(f.getCommand(), map[string]string{
+		"subaccount": subaccountId,
+		"id":         brokerId,
+		"confirm":    "true",
+	}))
+}
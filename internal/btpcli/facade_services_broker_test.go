@@ -2,6 +2,7 @@ package btpcli
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -144,3 +145,162 @@ func TestServicesBrokerFacade_GetByName(t *testing.T) {
 		}
 	})
 }
+
+func TestServicesBrokerFacade_Register(t *testing.T) {
+	command := "services/broker"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	brokerId := "b3a4c5d6-1234-4e5f-8a9b-0c1d2e3f4a5b"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionRegister, map[string]string{
+				"subaccount":  subaccountId,
+				"name":        "my-broker",
+				"description": "my description",
+				"url":         "https://broker.example.com",
+				"user":        "user",
+				"password":    "password",
+				"labels":      `{"a":["b"]}`,
+			})
+
+			fmt.Fprintf(w, `{"id": "%s", "name": "my-broker"}`, brokerId)
+		}))
+		defer srv.Close()
+
+		broker, res, err := uut.Services.Broker.Register(context.TODO(), &ServiceBrokerRegisterInput{
+			Subaccount:  subaccountId,
+			Name:        "my-broker",
+			Description: "my description",
+			URL:         "https://broker.example.com",
+			User:        "user",
+			Password:    "password",
+			Labels:      map[string][]string{"a": {"b"}},
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+			assert.Equal(t, brokerId, broker.Id)
+		}
+	})
+	t.Run("reads the broker if not part of the response", func(t *testing.T) {
+		var calls int
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+
+			if calls == 1 {
+				assertCall(t, r, command, ActionRegister, map[string]string{
+					"subaccount": subaccountId,
+					"name":       "my-broker",
+					"url":        "https://broker.example.com",
+					"user":       "user",
+					"password":   "password",
+				})
+				return
+			}
+
+			assertCall(t, r, command, ActionGet, map[string]string{
+				"subaccount": subaccountId,
+				"name":       "my-broker",
+			})
+			fmt.Fprintf(w, `{"id": "%s", "name": "my-broker"}`, brokerId)
+		}))
+		defer srv.Close()
+
+		broker, _, err := uut.Services.Broker.Register(context.TODO(), &ServiceBrokerRegisterInput{
+			Subaccount: subaccountId,
+			Name:       "my-broker",
+			URL:        "https://broker.example.com",
+			User:       "user",
+			Password:   "password",
+		})
+
+		if assert.Equal(t, 2, calls) && assert.NoError(t, err) {
+			assert.Equal(t, brokerId, broker.Id)
+		}
+	})
+}
+
+func TestServicesBrokerFacade_Update(t *testing.T) {
+	command := "services/broker"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	brokerId := "b3a4c5d6-1234-4e5f-8a9b-0c1d2e3f4a5b"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var calls int
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+
+			if calls == 1 {
+				assertCall(t, r, command, ActionUpdate, map[string]string{
+					"subaccount":  subaccountId,
+					"id":          brokerId,
+					"newName":     "my-new-broker",
+					"description": "my description",
+					"url":         "https://broker.example.com",
+					"user":        "user",
+					"password":    "password",
+					"labels":      `[{"op":"add","key":"a","values":["b"]}]`,
+				})
+				return
+			}
+
+			assertCall(t, r, command, ActionGet, map[string]string{
+				"subaccount": subaccountId,
+				"id":         brokerId,
+			})
+			fmt.Fprintf(w, `{"id": "%s", "name": "my-new-broker"}`, brokerId)
+		}))
+		defer srv.Close()
+
+		broker, _, err := uut.Services.Broker.Update(context.TODO(), &ServiceBrokerUpdateInput{
+			Id:          brokerId,
+			Subaccount:  subaccountId,
+			NewName:     "my-new-broker",
+			Description: "my description",
+			URL:         "https://broker.example.com",
+			User:        "user",
+			Password:    "password",
+			LabelsPlan:  map[string][]string{"a": {"b"}},
+		})
+
+		if assert.Equal(t, 2, calls) && assert.NoError(t, err) {
+			assert.Equal(t, "my-new-broker", broker.Name)
+		}
+	})
+}
+
+func TestServicesBrokerFacade_Unregister(t *testing.T) {
+	command := "services/broker"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	brokerId := "b3a4c5d6-1234-4e5f-8a9b-0c1d2e3f4a5b"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUnregister, map[string]string{
+				"subaccount": subaccountId,
+				"id":         brokerId,
+				"confirm":    "true",
+			})
+		}))
+		defer srv.Close()
+
+		res, err := uut.Services.Broker.Unregister(context.TODO(), subaccountId, brokerId)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
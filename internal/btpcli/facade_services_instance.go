@@ -93,10 +93,13 @@ func (f servicesInstanceFacade) doGet(ctx context.Context, params map[string]str
 	return
 }
 
+// ServiceInstanceCreateInput identifies the service plan either by its ID or by the names of the service offering and plan
 type ServiceInstanceCreateInput struct {
 	Name          string              `btpcli:"name"`
 	Subaccount    string              `btpcli:"subaccount"`
 	ServicePlanId string              `btpcli:"plan"`
+	OfferingName  string              `btpcli:"offeringName"`
+	PlanName      string              `btpcli:"planName"`
 	Parameters    *string             `btpcli:"parameters"`
 	Labels        map[string][]string `btpcli:"labels"`
 }
@@ -173,6 +176,23 @@ func (f servicesInstanceFacade) Delete(ctx context.Context, subaccountId string,
 	return res, err
 }
 
+// Share shares the service instance with the other environments of the subaccount, e.g. Cloud Foundry spaces and Kyma namespaces
+func (f servicesInstanceFacade) Share(ctx context.Context, subaccountId string, instanceId string) (CommandResponse, error) {
+	return f.cliClient.Execute(ctx, NewShareRequest(f.getCommand(), map[string]string{
+		"subaccount": subaccountId,
+		"id":         instanceId,
+	}))
+}
+
+// Unshare revokes the sharing of the service instance. This fails as long as other environments still reference the instance.
+func (f servicesInstanceFacade) Unshare(ctx context.Context, subaccountId string, instanceId string) (CommandResponse, error) {
+	return f.cliClient.Execute(ctx, NewUnshareRequest(f.getCommand(), map[string]string{
+		"subaccount": subaccountId,
+		"id":         instanceId,
+		"confirm":    "true",
+	}))
+}
+
 func computeLabelParam(labelsPlan map[string][]string, labelsState map[string][]string) string {
 
 	var labelEntry servicemanager.Label
@@ -232,6 +232,32 @@ func TestServicesInstanceFacade_Create(t *testing.T) {
 			Labels:        labels,
 		})
 
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+	t.Run("constructs the CLI params correctly - with offering and plan name", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionCreate, map[string]string{
+				"subaccount":   subaccountId,
+				"name":         instanceName,
+				"offeringName": "alert-notification",
+				"planName":     "free",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Services.Instance.Create(context.TODO(), &ServiceInstanceCreateInput{
+			Name:         instanceName,
+			Subaccount:   subaccountId,
+			OfferingName: "alert-notification",
+			PlanName:     "free",
+		})
+
 		if assert.True(t, srvCalled) && assert.NoError(t, err) {
 			assert.Equal(t, 200, res.StatusCode)
 		}
@@ -266,6 +292,61 @@ func TestServicesInstanceFacade_Delete(t *testing.T) {
 	})
 }
 
+func TestServicesInstanceFacade_Share(t *testing.T) {
+	command := "services/instance"
+
+	subaccountId := "59cd458e-e66e-4b60-b6d8-8f219379f9a5"
+	instanceId := "bc8a216f-1184-49dc-b4b4-17cfe2828965"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionShare, map[string]string{
+				"subaccount": subaccountId,
+				"id":         instanceId,
+			})
+		}))
+		defer srv.Close()
+
+		res, err := uut.Services.Instance.Share(context.TODO(), subaccountId, instanceId)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestServicesInstanceFacade_Unshare(t *testing.T) {
+	command := "services/instance"
+
+	subaccountId := "59cd458e-e66e-4b60-b6d8-8f219379f9a5"
+	instanceId := "bc8a216f-1184-49dc-b4b4-17cfe2828965"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUnshare, map[string]string{
+				"subaccount": subaccountId,
+				"id":         instanceId,
+				"confirm":    "true",
+			})
+		}))
+		defer srv.Close()
+
+		res, err := uut.Services.Instance.Unshare(context.TODO(), subaccountId, instanceId)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
 func TestServicesInstanceFacade_ComputeLabelDiff(t *testing.T) {
 
 	tests := []struct {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
 )
 
 func newServicesPlatformFacade(cliClient *v2Client) servicesPlatformFacade {
@@ -47,3 +48,63 @@ func (f servicesPlatformFacade) GetByName(ctx context.Context, subaccountId stri
 		"name":       platformName,
 	}))
 }
+
+type ServicePlatformRegisterInput struct {
+	Subaccount  string              `btpcli:"subaccount"`
+	Name        string              `btpcli:"name"`
+	Type        string              `btpcli:"type"`
+	Description string              `btpcli:"description"`
+	Labels      map[string][]string `btpcli:"labels"`
+}
+
+// Register registers a platform. The credentials of the platform are only part of the response of this call.
+func (f servicesPlatformFacade) Register(ctx context.Context, args *ServicePlatformRegisterInput) (servicemanager.RegisteredPlatformResponseObject, CommandResponse, error) {
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return servicemanager.RegisteredPlatformResponseObject{}, CommandResponse{}, err
+	}
+
+	return doExecute[servicemanager.RegisteredPlatformResponseObject](f.cliClient, ctx, NewRegisterRequest(f.getCommand(), params))
+}
+
+type ServicePlatformUpdateInput struct {
+	Id          string `btpcli:"id"`
+	Subaccount  string `btpcli:"subaccount"`
+	NewName     string `btpcli:"newName"`
+	Description string `btpcli:"description"`
+	LabelsPlan  map[string][]string
+	LabelsState map[string][]string
+}
+
+func (f servicesPlatformFacade) Update(ctx context.Context, args *ServicePlatformUpdateInput) (servicemanager.PlatformResponseObject, CommandResponse, error) {
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return servicemanager.PlatformResponseObject{}, CommandResponse{}, err
+	}
+
+	if computedLabels := computeLabelParam(args.LabelsPlan, args.LabelsState); computedLabels != "" {
+		// Parameter must only be added to call if non-empty
+		params["labels"] = computedLabels
+	}
+
+	// The CLI server does not necessarily return the updated platform, so it is read again to get a consistent response
+	res, err := f.cliClient.Execute(ctx, NewUpdateRequest(f.getCommand(), params))
+
+	if err != nil {
+		return servicemanager.PlatformResponseObject{}, res, err
+	}
+
+	res.Body.Close()
+
+	return f.GetById(ctx, args.Subaccount, args.Id)
+}
+
+func (f servicesPlatformFacade) Unregister(ctx context.Context, subaccountId string, platformId string) (CommandResponse, error) {
+	return f.cliClient.Execute(ctx, NewUnregisterRequest(f.getCommand(), map[string]string{
+		"subaccount": subaccountId,
+		"id":         platformId,
+		"confirm":    "true",
+	}))
+}
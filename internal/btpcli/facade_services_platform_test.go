@@ -2,6 +2,7 @@ package btpcli
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -144,3 +145,118 @@ func TestServicesPlatformFacade_GetByName(t *testing.T) {
 		}
 	})
 }
+
+func TestServicesPlatformFacade_Register(t *testing.T) {
+	command := "services/platform"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	platformId := "e1c2a3b4-5678-4d9e-8f0a-1b2c3d4e5f6a"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionRegister, map[string]string{
+				"subaccount":  subaccountId,
+				"name":        "my-cluster",
+				"type":        "kubernetes",
+				"description": "my description",
+				"labels":      `{"a":["b"]}`,
+			})
+
+			fmt.Fprintf(w, `{"id": "%s", "name": "my-cluster", "credentials": {"basic": {"username": "user", "password": "password"}}, "labels": "a = b; subaccount_id = %s"}`, platformId, subaccountId)
+		}))
+		defer srv.Close()
+
+		platform, res, err := uut.Services.Platform.Register(context.TODO(), &ServicePlatformRegisterInput{
+			Subaccount:  subaccountId,
+			Name:        "my-cluster",
+			Type:        "kubernetes",
+			Description: "my description",
+			Labels:      map[string][]string{"a": {"b"}},
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+			assert.Equal(t, platformId, platform.Id)
+			assert.Equal(t, "user", platform.Credentials.Basic.Username)
+			assert.Equal(t, "password", platform.Credentials.Basic.Password)
+			assert.Equal(t, []string{"b"}, platform.Labels["a"])
+		}
+	})
+}
+
+func TestServicesPlatformFacade_Update(t *testing.T) {
+	command := "services/platform"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	platformId := "e1c2a3b4-5678-4d9e-8f0a-1b2c3d4e5f6a"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var calls int
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+
+			if calls == 1 {
+				assertCall(t, r, command, ActionUpdate, map[string]string{
+					"subaccount":  subaccountId,
+					"id":          platformId,
+					"newName":     "my-new-cluster",
+					"description": "my description",
+					"labels":      `[{"op":"remove","key":"a","values":["b"]}]`,
+				})
+				return
+			}
+
+			assertCall(t, r, command, ActionGet, map[string]string{
+				"subaccount": subaccountId,
+				"id":         platformId,
+			})
+			fmt.Fprintf(w, `{"id": "%s", "name": "my-new-cluster"}`, platformId)
+		}))
+		defer srv.Close()
+
+		platform, _, err := uut.Services.Platform.Update(context.TODO(), &ServicePlatformUpdateInput{
+			Id:          platformId,
+			Subaccount:  subaccountId,
+			NewName:     "my-new-cluster",
+			Description: "my description",
+			LabelsState: map[string][]string{"a": {"b"}},
+		})
+
+		if assert.Equal(t, 2, calls) && assert.NoError(t, err) {
+			assert.Equal(t, "my-new-cluster", platform.Name)
+		}
+	})
+}
+
+func TestServicesPlatformFacade_Unregister(t *testing.T) {
+	command := "services/platform"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	platformId := "e1c2a3b4-5678-4d9e-8f0a-1b2c3d4e5f6a"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUnregister, map[string]string{
+				"subaccount": subaccountId,
+				"id":         platformId,
+				"confirm":    "true",
+			})
+		}))
+		defer srv.Close()
+
+		res, err := uut.Services.Platform.Unregister(context.TODO(), subaccountId, platformId)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
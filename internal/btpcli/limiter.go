@@ -0,0 +1,162 @@
+package btpcli
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
+)
+
+// RequestLimits throttles the requests sent to the CLI server, e.g. to stay below its rate limits when Terraform
+// manages many resources in parallel.
+type RequestLimits struct {
+	// MaxConcurrentRequests is the maximum number of requests in flight at the same time. Zero means no limit.
+	MaxConcurrentRequests int
+
+	// RequestsPerSecond is the maximum rate at which requests are sent. Zero means no limit.
+	RequestsPerSecond float64
+
+	// SerializeSubaccountRequests makes sure that at most one modifying command is executed per subaccount at a time,
+	// as concurrent changes of e.g. entitlements or role collections in the same subaccount conflict with each other.
+	SerializeSubaccountRequests bool
+}
+
+// contextKeyLockedSubaccount carries the subaccount which is locked for a sequence of commands, see LockSubaccount
+const contextKeyLockedSubaccount v2ContextKey = "lockedSubaccount"
+
+// requestLimiter enforces the RequestLimits of a client. A nil limiter doesn't throttle the requests at all.
+type requestLimiter struct {
+	concurrency chan struct{}
+	rate        *rate.Limiter
+
+	serializeSubaccounts bool
+	subaccountsMutex     sync.Mutex
+	subaccounts          map[string]chan struct{}
+}
+
+// newRequestLimiter returns a limiter for the given limits, or nil if no limits are set
+func newRequestLimiter(limits RequestLimits) *requestLimiter {
+	if limits == (RequestLimits{}) {
+		return nil
+	}
+
+	limiter := &requestLimiter{
+		serializeSubaccounts: limits.SerializeSubaccountRequests,
+		subaccounts:          map[string]chan struct{}{},
+	}
+
+	if limits.MaxConcurrentRequests > 0 {
+		limiter.concurrency = make(chan struct{}, limits.MaxConcurrentRequests)
+	}
+
+	if limits.RequestsPerSecond > 0 {
+		limiter.rate = rate.NewLimiter(rate.Limit(limits.RequestsPerSecond), 1)
+	}
+
+	return limiter
+}
+
+// acquire blocks until the request may be sent. The returned function must be called once the request has completed.
+// An empty subaccount means that the request does not need to be serialized.
+func (l *requestLimiter) acquire(ctx context.Context, subaccount string) (release func(), err error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	var releaseFuncs []func()
+
+	release = func() {
+		for i := len(releaseFuncs) - 1; i >= 0; i-- {
+			releaseFuncs[i]()
+		}
+	}
+
+	if locked, _ := ctx.Value(contextKeyLockedSubaccount).(string); locked == subaccount {
+		// the subaccount is already locked for the whole sequence of commands this request belongs to
+		subaccount = ""
+	}
+
+	if l.serializeSubaccounts && len(subaccount) > 0 {
+		// the subaccount is locked first, so that waiting requests do not block any concurrency slots
+		if err := acquireSlot(ctx, l.subaccountLock(subaccount), &releaseFuncs); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	if l.concurrency != nil {
+		if err := acquireSlot(ctx, l.concurrency, &releaseFuncs); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+// lockSubaccount locks the subaccount until the returned function is called, so that a sequence of commands, e.g. a
+// creation and the polling of its result, is not interleaved with the changes of other resources in the same subaccount.
+// The returned context must be used for the commands of the sequence.
+func (l *requestLimiter) lockSubaccount(ctx context.Context, subaccount string) (context.Context, func(), error) {
+	if locked, _ := ctx.Value(contextKeyLockedSubaccount).(string); l == nil || !l.serializeSubaccounts || len(subaccount) == 0 || locked == subaccount {
+		return ctx, func() {}, nil
+	}
+
+	var releaseFuncs []func()
+	if err := acquireSlot(ctx, l.subaccountLock(subaccount), &releaseFuncs); err != nil {
+		return ctx, nil, err
+	}
+
+	return context.WithValue(ctx, contextKeyLockedSubaccount, subaccount), releaseFuncs[0], nil
+}
+
+// acquireSlot blocks until the given semaphore has a free slot or the context is done
+func acquireSlot(ctx context.Context, semaphore chan struct{}, releaseFuncs *[]func()) error {
+	select {
+	case semaphore <- struct{}{}:
+		*releaseFuncs = append(*releaseFuncs, func() { <-semaphore })
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// subaccountLock returns the semaphore which serializes the requests against the given subaccount
+func (l *requestLimiter) subaccountLock(subaccount string) chan struct{} {
+	l.subaccountsMutex.Lock()
+	defer l.subaccountsMutex.Unlock()
+
+	if _, exists := l.subaccounts[subaccount]; !exists {
+		l.subaccounts[subaccount] = make(chan struct{}, 1)
+	}
+
+	return l.subaccounts[subaccount]
+}
+
+// subaccountOf returns the subaccount addressed by the given command arguments, if any
+func subaccountOf(args any) string {
+	params, ok := args.(map[string]string)
+
+	if !ok {
+		// command inputs like SubaccountUpdateInput are mapped the same way as they are sent to the CLI server
+		var err error
+		if params, err = tfutils.ToBTPCLIParamsMap(args); err != nil {
+			return ""
+		}
+	}
+
+	if subaccount, ok := params["subaccount"]; ok {
+		return subaccount
+	}
+
+	return params["subaccountID"]
+}
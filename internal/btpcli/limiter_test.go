@@ -0,0 +1,177 @@
+package btpcli
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestLimiter(t *testing.T) {
+	t.Parallel()
+
+	// runConcurrently executes the given number of requests in parallel and returns the maximum number of requests in flight
+	runConcurrently := func(t *testing.T, uut *requestLimiter, requests int, subaccountFor func(i int) string) int32 {
+		var inFlight, maxInFlight atomic.Int32
+		var wg sync.WaitGroup
+
+		for i := 0; i < requests; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				release, err := uut.acquire(context.TODO(), subaccountFor(i))
+				if !assert.NoError(t, err) {
+					return
+				}
+				defer release()
+
+				current := inFlight.Add(1)
+				for {
+					observed := maxInFlight.Load()
+					if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+						break
+					}
+				}
+
+				time.Sleep(10 * time.Millisecond)
+				inFlight.Add(-1)
+			}(i)
+		}
+		wg.Wait()
+
+		return maxInFlight.Load()
+	}
+
+	t.Run("happy path - no limits", func(t *testing.T) {
+		uut := newRequestLimiter(RequestLimits{})
+		assert.Nil(t, uut)
+
+		assert.Equal(t, int32(10), runConcurrently(t, uut, 10, func(int) string { return "" }))
+	})
+	t.Run("happy path - concurrent requests are limited", func(t *testing.T) {
+		uut := newRequestLimiter(RequestLimits{MaxConcurrentRequests: 2})
+
+		assert.Equal(t, int32(2), runConcurrently(t, uut, 10, func(int) string { return "" }))
+	})
+	t.Run("happy path - requests against the same subaccount are serialized", func(t *testing.T) {
+		uut := newRequestLimiter(RequestLimits{SerializeSubaccountRequests: true})
+
+		assert.Equal(t, int32(1), runConcurrently(t, uut, 5, func(int) string { return "subaccount-1" }))
+	})
+	t.Run("happy path - requests against different subaccounts are not serialized", func(t *testing.T) {
+		uut := newRequestLimiter(RequestLimits{SerializeSubaccountRequests: true})
+
+		assert.Equal(t, int32(2), runConcurrently(t, uut, 10, func(i int) string {
+			if i%2 == 0 {
+				return "subaccount-1"
+			}
+			return "subaccount-2"
+		}))
+	})
+	t.Run("happy path - requests per second are limited", func(t *testing.T) {
+		uut := newRequestLimiter(RequestLimits{RequestsPerSecond: 20})
+
+		start := time.Now()
+		for i := 0; i < 5; i++ {
+			release, err := uut.acquire(context.TODO(), "")
+			assert.NoError(t, err)
+			release()
+		}
+
+		assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+	})
+	t.Run("error path - waiting is canceled with the context", func(t *testing.T) {
+		uut := newRequestLimiter(RequestLimits{MaxConcurrentRequests: 1, SerializeSubaccountRequests: true})
+
+		release, err := uut.acquire(context.TODO(), "subaccount-1")
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = uut.acquire(ctx, "")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		_, err = uut.acquire(ctx, "subaccount-1")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		release()
+
+		release, err = uut.acquire(context.TODO(), "subaccount-1")
+		assert.NoError(t, err)
+		release()
+	})
+}
+
+func TestSubaccountOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "subaccount-1", subaccountOf(map[string]string{"subaccount": "subaccount-1"}))
+	assert.Equal(t, "subaccount-1", subaccountOf(map[string]string{"subaccountID": "subaccount-1"}))
+	assert.Equal(t, "", subaccountOf(map[string]string{"globalAccount": "ga"}))
+	assert.Equal(t, "", subaccountOf(nil))
+	assert.Equal(t, "subaccount-1", subaccountOf(&SubaccountUpdateInput{SubaccountId: "subaccount-1", DisplayName: "my-subaccount"}))
+	assert.Equal(t, "", subaccountOf(&DirectoryUpdateInput{DirectoryId: "directory-1"}))
+}
+
+func TestRequestLimiter_LockSubaccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path - sequence of requests holds the subaccount", func(t *testing.T) {
+		uut := newRequestLimiter(RequestLimits{SerializeSubaccountRequests: true})
+
+		ctx, unlock, err := uut.lockSubaccount(context.TODO(), "subaccount-1")
+		assert.NoError(t, err)
+
+		// the requests of the sequence do not wait for the lock they already hold
+		for i := 0; i < 2; i++ {
+			release, err := uut.acquire(ctx, "subaccount-1")
+			assert.NoError(t, err)
+			release()
+		}
+
+		// other requests against the subaccount wait until the sequence has completed
+		waitCtx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = uut.acquire(waitCtx, "subaccount-1")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		release, err := uut.acquire(context.TODO(), "subaccount-2")
+		assert.NoError(t, err)
+		release()
+
+		unlock()
+
+		release, err = uut.acquire(context.TODO(), "subaccount-1")
+		assert.NoError(t, err)
+		release()
+	})
+	t.Run("happy path - nothing is locked if the subaccounts are not serialized", func(t *testing.T) {
+		uut := newRequestLimiter(RequestLimits{})
+
+		_, unlock, err := uut.lockSubaccount(context.TODO(), "subaccount-1")
+		assert.NoError(t, err)
+		defer unlock()
+
+		release, err := uut.acquire(context.TODO(), "subaccount-1")
+		assert.NoError(t, err)
+		release()
+	})
+	t.Run("happy path - nested locks of the same subaccount", func(t *testing.T) {
+		uut := newRequestLimiter(RequestLimits{SerializeSubaccountRequests: true})
+
+		ctx, unlock, err := uut.lockSubaccount(context.TODO(), "subaccount-1")
+		assert.NoError(t, err)
+
+		_, unlockNested, err := uut.lockSubaccount(ctx, "subaccount-1")
+		assert.NoError(t, err)
+		unlockNested()
+
+		unlock()
+	})
+}
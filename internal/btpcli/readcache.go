@@ -0,0 +1,144 @@
+package btpcli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// contextKeyWithoutReadCache marks requests which must not be served from the read cache
+const contextKeyWithoutReadCache v2ContextKey = "withoutReadCache"
+
+// WithoutReadCache returns a context in which get and list commands bypass the read cache, e.g. when polling for a
+// state change.
+func WithoutReadCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKeyWithoutReadCache, true)
+}
+
+// readCacheScope is the subaccount, directory or global account (empty kind and id) a command refers to
+type readCacheScope struct {
+	kind string
+	id   string
+}
+
+func scopeOf(args any) readCacheScope {
+	if subaccount := subaccountOf(args); len(subaccount) > 0 {
+		return readCacheScope{kind: "subaccount", id: subaccount}
+	}
+
+	if params, ok := args.(map[string]string); ok {
+		if directory, ok := params["directory"]; ok {
+			return readCacheScope{kind: "directory", id: directory}
+		}
+
+		if directory, ok := params["directoryID"]; ok {
+			return readCacheScope{kind: "directory", id: directory}
+		}
+	}
+
+	return readCacheScope{}
+}
+
+// isAffectedBy returns whether a change in the given scope may change the result of a read in this scope. Only reads
+// of other subaccounts or directories than the changed one are considered unaffected, since e.g. the global account
+// hierarchy reflects changes of any of its subaccounts and directories.
+func (s readCacheScope) isAffectedBy(changed readCacheScope) bool {
+	return len(changed.kind) == 0 || s.kind != changed.kind || s.id == changed.id
+}
+
+type readCacheEntry struct {
+	scope       readCacheScope
+	expiresAt   time.Time
+	statusCode  int
+	contentType string
+	body        []byte
+}
+
+// readCache memoizes the responses of get and list commands for a limited time
+type readCache struct {
+	sync.Mutex
+
+	ttl     time.Duration
+	entries map[string]readCacheEntry
+	now     func() time.Time
+}
+
+func newReadCache(ttl time.Duration) *readCache {
+	return &readCache{
+		ttl:     ttl,
+		entries: map[string]readCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+func readCacheKey(cmdReq *CommandRequest) (string, error) {
+	// maps are encoded with sorted keys, so that the key does not depend on the order of the arguments
+	args, err := json.Marshal(cmdReq.Args)
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s?%s:%s", cmdReq.Command, cmdReq.Action, args), nil
+}
+
+// get returns a copy of the cached response for the given key, if any
+func (c *readCache) get(key string) (CommandResponse, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	entry, found := c.entries[key]
+
+	if !found || c.now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return CommandResponse{}, false
+	}
+
+	return CommandResponse{
+		StatusCode:  entry.statusCode,
+		ContentType: entry.contentType,
+		Body:        io.NopCloser(bytes.NewReader(entry.body)),
+	}, true
+}
+
+// put caches the given response and returns a replacement for it, as the response body is consumed
+func (c *readCache) put(key string, scope readCacheScope, cmdRes CommandResponse) (CommandResponse, error) {
+	defer cmdRes.Body.Close()
+
+	body, err := io.ReadAll(cmdRes.Body)
+
+	if err != nil {
+		return cmdRes, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.entries[key] = readCacheEntry{
+		scope:       scope,
+		expiresAt:   c.now().Add(c.ttl),
+		statusCode:  cmdRes.StatusCode,
+		contentType: cmdRes.ContentType,
+		body:        body,
+	}
+
+	cmdRes.Body = io.NopCloser(bytes.NewReader(body))
+
+	return cmdRes, nil
+}
+
+// invalidate removes all entries which may be affected by a change in the given scope
+func (c *readCache) invalidate(changed readCacheScope) {
+	c.Lock()
+	defer c.Unlock()
+
+	for key, entry := range c.entries {
+		if entry.scope.isAffectedBy(changed) {
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package btpcli
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopeOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, readCacheScope{kind: "subaccount", id: "sa-1"}, scopeOf(map[string]string{"subaccount": "sa-1", "directory": "dir-1"}))
+	assert.Equal(t, readCacheScope{kind: "subaccount", id: "sa-1"}, scopeOf(map[string]string{"subaccountID": "sa-1"}))
+	assert.Equal(t, readCacheScope{kind: "directory", id: "dir-1"}, scopeOf(map[string]string{"directory": "dir-1"}))
+	assert.Equal(t, readCacheScope{kind: "directory", id: "dir-1"}, scopeOf(map[string]string{"directoryID": "dir-1"}))
+	assert.Equal(t, readCacheScope{}, scopeOf(map[string]string{"globalAccount": "ga"}))
+}
+
+func TestReadCacheScope_IsAffectedBy(t *testing.T) {
+	t.Parallel()
+
+	globalAccount := readCacheScope{}
+	subaccount1 := readCacheScope{kind: "subaccount", id: "sa-1"}
+	subaccount2 := readCacheScope{kind: "subaccount", id: "sa-2"}
+	directory1 := readCacheScope{kind: "directory", id: "dir-1"}
+	directory2 := readCacheScope{kind: "directory", id: "dir-2"}
+
+	assert.True(t, subaccount1.isAffectedBy(subaccount1))
+	assert.False(t, subaccount1.isAffectedBy(subaccount2))
+	assert.True(t, subaccount1.isAffectedBy(directory1))
+	assert.True(t, subaccount1.isAffectedBy(globalAccount))
+
+	assert.True(t, directory1.isAffectedBy(directory1))
+	assert.False(t, directory1.isAffectedBy(directory2))
+	assert.True(t, directory1.isAffectedBy(subaccount1))
+
+	assert.True(t, globalAccount.isAffectedBy(subaccount1))
+	assert.True(t, globalAccount.isAffectedBy(directory1))
+	assert.True(t, globalAccount.isAffectedBy(globalAccount))
+}
+
+func TestReadCache(t *testing.T) {
+	t.Parallel()
+
+	response := func(body string) CommandResponse {
+		return CommandResponse{StatusCode: 200, ContentType: "application/json", Body: io.NopCloser(bytes.NewBufferString(body))}
+	}
+
+	readBody := func(t *testing.T, cmdRes CommandResponse) string {
+		body, err := io.ReadAll(cmdRes.Body)
+		assert.NoError(t, err)
+		return string(body)
+	}
+
+	t.Run("happy path - cached response can be read repeatedly", func(t *testing.T) {
+		uut := newReadCache(time.Minute)
+
+		cmdRes, err := uut.put("key", readCacheScope{}, response("{}"))
+		assert.NoError(t, err)
+		assert.Equal(t, "{}", readBody(t, cmdRes))
+
+		for i := 0; i < 2; i++ {
+			cmdRes, found := uut.get("key")
+
+			if assert.True(t, found) {
+				assert.Equal(t, 200, cmdRes.StatusCode)
+				assert.Equal(t, "application/json", cmdRes.ContentType)
+				assert.Equal(t, "{}", readBody(t, cmdRes))
+			}
+		}
+	})
+	t.Run("happy path - entries expire", func(t *testing.T) {
+		now := time.Now()
+		uut := newReadCache(time.Minute)
+		uut.now = func() time.Time { return now }
+
+		_, err := uut.put("key", readCacheScope{}, response("{}"))
+		assert.NoError(t, err)
+
+		now = now.Add(2 * time.Minute)
+
+		_, found := uut.get("key")
+		assert.False(t, found)
+	})
+	t.Run("happy path - only affected entries are invalidated", func(t *testing.T) {
+		uut := newReadCache(time.Minute)
+
+		_, _ = uut.put("subaccount-1", readCacheScope{kind: "subaccount", id: "sa-1"}, response("{}"))
+		_, _ = uut.put("subaccount-2", readCacheScope{kind: "subaccount", id: "sa-2"}, response("{}"))
+		_, _ = uut.put("hierarchy", readCacheScope{}, response("{}"))
+
+		uut.invalidate(readCacheScope{kind: "subaccount", id: "sa-1"})
+
+		_, found := uut.get("subaccount-1")
+		assert.False(t, found)
+		_, found = uut.get("hierarchy")
+		assert.False(t, found)
+		_, found = uut.get("subaccount-2")
+		assert.True(t, found)
+	})
+}
+
+func TestV2Client_ReadCache(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) (*v2Client, *fakeCLIServer) {
+		fakeSrv := &fakeCLIServer{validSessionId: "session-1"}
+		srv := httptest.NewServer(fakeSrv)
+		t.Cleanup(srv.Close)
+
+		srvUrl, _ := url.Parse(srv.URL)
+		uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl)
+		uut.session = &Session{SessionId: "session-1"}
+		uut.EnableReadCache(time.Minute)
+
+		return uut, fakeSrv
+	}
+
+	execute := func(t *testing.T, uut *v2Client, ctx context.Context, cmdReq *CommandRequest) {
+		_, err := uut.Execute(ctx, cmdReq)
+		assert.NoError(t, err)
+	}
+
+	t.Run("happy path - repeated reads are served from the cache", func(t *testing.T) {
+		uut, fakeSrv := setup(t)
+
+		execute(t, uut, context.TODO(), NewGetRequest("accounts/global-account", map[string]string{"globalAccount": "ga", "showHierarchy": "true"}))
+		execute(t, uut, context.TODO(), NewGetRequest("accounts/global-account", map[string]string{"showHierarchy": "true", "globalAccount": "ga"}))
+		execute(t, uut, context.TODO(), NewGetRequest("accounts/global-account", map[string]string{"globalAccount": "ga"}))
+
+		assert.Equal(t, 2, fakeSrv.commands)
+	})
+	t.Run("happy path - changes invalidate the cache", func(t *testing.T) {
+		uut, fakeSrv := setup(t)
+
+		execute(t, uut, context.TODO(), NewGetRequest("accounts/global-account", map[string]string{"globalAccount": "ga", "showHierarchy": "true"}))
+		execute(t, uut, context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "sa-2"}))
+		execute(t, uut, context.TODO(), NewDeleteRequest("accounts/subaccount", map[string]string{"subaccount": "sa-1"}))
+		execute(t, uut, context.TODO(), NewGetRequest("accounts/global-account", map[string]string{"globalAccount": "ga", "showHierarchy": "true"}))
+		execute(t, uut, context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "sa-2"}))
+
+		assert.Equal(t, 4, fakeSrv.commands)
+	})
+	t.Run("happy path - polling bypasses the cache", func(t *testing.T) {
+		uut, fakeSrv := setup(t)
+
+		execute(t, uut, context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "sa-1"}))
+		execute(t, uut, WithoutReadCache(context.TODO()), NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "sa-1"}))
+
+		assert.Equal(t, 2, fakeSrv.commands)
+	})
+	t.Run("happy path - disabled by default", func(t *testing.T) {
+		uut, fakeSrv := setup(t)
+		uut.readCache = nil
+
+		execute(t, uut, context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "sa-1"}))
+		execute(t, uut, context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{"subaccount": "sa-1"}))
+
+		assert.Equal(t, 2, fakeSrv.commands)
+	})
+}
@@ -0,0 +1,95 @@
+package btpcli
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const HeaderRetryAfter string = "Retry-After"
+
+// RetryPolicy defines how often and how long the client waits before a failed command gets executed again.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the initial attempt. Zero disables retries.
+	MaxRetries int
+
+	// MinBackoff is the backoff before the first retry. It doubles with every further retry.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the backoff, including backoffs requested by the server via the Retry-After header.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is used by all clients unless configured otherwise.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: 1 * time.Second,
+	MaxBackoff: 30 * time.Second,
+}
+
+// retriableStatusCodes are the status codes that indicate a transient failure of the CLI server or the backend
+var retriableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// isIdempotentAction returns true for actions which can safely be executed more than once
+func isIdempotentAction(action Action) bool {
+	return action == ActionGet || action == ActionList
+}
+
+// isRetriableResponse returns true if either the CLI server or the backend reported a transient failure
+func isRetriableResponse(res *http.Response) bool {
+	if retriableStatusCodes[res.StatusCode] {
+		return true
+	}
+
+	backendStatus, err := strconv.Atoi(res.Header.Get(HeaderCLIBackendStatus))
+
+	return err == nil && retriableStatusCodes[backendStatus]
+}
+
+// backoff calculates the time to wait before the given retry (starting at 0). A delay requested by the
+// server via the Retry-After header takes precedence over the exponential backoff.
+func (p RetryPolicy) backoff(retry int, retryAfter string) time.Duration {
+	if delay, ok := parseRetryAfter(retryAfter); ok {
+		return min(delay, p.MaxBackoff)
+	}
+
+	delay := p.MinBackoff
+	for i := 0; i < retry && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, p.MaxBackoff)
+
+	if delay <= 0 {
+		return 0
+	}
+
+	// equal jitter: wait at least half of the delay to keep the backoff exponential
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either given in seconds or as HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
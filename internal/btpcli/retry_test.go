@@ -0,0 +1,88 @@
+package btpcli
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsIdempotentAction(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isIdempotentAction(ActionGet))
+	assert.True(t, isIdempotentAction(ActionList))
+	assert.False(t, isIdempotentAction(ActionCreate))
+	assert.False(t, isIdempotentAction(ActionDelete))
+	assert.False(t, isIdempotentAction(ActionUpdate))
+}
+
+func TestIsRetriableResponse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		status        int
+		backendStatus string
+		expected      bool
+	}{
+		{description: "ok", status: http.StatusOK, backendStatus: "200", expected: false},
+		{description: "too many requests", status: http.StatusTooManyRequests, expected: true},
+		{description: "bad gateway", status: http.StatusBadGateway, expected: true},
+		{description: "service unavailable", status: http.StatusServiceUnavailable, expected: true},
+		{description: "gateway timeout", status: http.StatusGatewayTimeout, expected: true},
+		{description: "internal server error", status: http.StatusInternalServerError, expected: false},
+		{description: "backend too many requests", status: http.StatusOK, backendStatus: "429", expected: true},
+		{description: "backend service unavailable", status: http.StatusOK, backendStatus: "503", expected: true},
+		{description: "backend not found", status: http.StatusOK, backendStatus: "404", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			res := &http.Response{StatusCode: test.status, Header: http.Header{}}
+			res.Header.Set(HeaderCLIBackendStatus, test.backendStatus)
+
+			assert.Equal(t, test.expected, isRetriableResponse(res))
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	t.Parallel()
+
+	uut := RetryPolicy{MaxRetries: 5, MinBackoff: 2 * time.Second, MaxBackoff: 10 * time.Second}
+
+	t.Run("grows exponentially with jitter", func(t *testing.T) {
+		for retry, expected := range []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second} {
+			backoff := uut.backoff(retry, "")
+
+			assert.GreaterOrEqual(t, backoff, expected/2)
+			assert.LessOrEqual(t, backoff, expected)
+		}
+	})
+	t.Run("is capped by max backoff", func(t *testing.T) {
+		backoff := uut.backoff(10, "")
+
+		assert.GreaterOrEqual(t, backoff, 5*time.Second)
+		assert.LessOrEqual(t, backoff, 10*time.Second)
+	})
+	t.Run("honors retry-after in seconds", func(t *testing.T) {
+		assert.Equal(t, 7*time.Second, uut.backoff(0, "7"))
+	})
+	t.Run("caps retry-after by max backoff", func(t *testing.T) {
+		assert.Equal(t, 10*time.Second, uut.backoff(0, "120"))
+	})
+	t.Run("honors retry-after as http date", func(t *testing.T) {
+		backoff := uut.backoff(0, time.Now().Add(5*time.Second).UTC().Format(http.TimeFormat))
+
+		assert.Greater(t, backoff, 3*time.Second)
+		assert.LessOrEqual(t, backoff, 5*time.Second)
+	})
+	t.Run("ignores invalid retry-after", func(t *testing.T) {
+		backoff := uut.backoff(0, "soon")
+
+		assert.GreaterOrEqual(t, backoff, 1*time.Second)
+		assert.LessOrEqual(t, backoff, 2*time.Second)
+	})
+}
@@ -0,0 +1,145 @@
+package btpcli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SessionCache persists sessions on disk, so that they can be shared across provider instances and Terraform runs
+// instead of logging in again every time. The cache files contain session ids and are only accessible by the owner.
+type SessionCache struct {
+	dir string
+}
+
+// NewSessionCache returns a SessionCache which stores the sessions in the given directory
+func NewSessionCache(dir string) *SessionCache {
+	return &SessionCache{dir: dir}
+}
+
+// DefaultSessionCacheDir returns the directory used for the session cache unless configured otherwise
+func DefaultSessionCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "terraform-provider-btp", "sessions"), nil
+}
+
+// cachedSession is the representation of a Session on disk
+type cachedSession struct {
+	GlobalAccountSubdomain string `json:"globalAccountSubdomain"`
+	SessionId              string `json:"sessionId"`
+	IdentityProvider       string `json:"identityProvider"`
+	Username               string `json:"username"`
+	Email                  string `json:"email"`
+	Issuer                 string `json:"issuer"`
+}
+
+// sessionCacheKey identifies the sessions of a user in a global account. Flows which do not know the user before
+// the login (e.g. the id token login) cannot be cached and use an empty key.
+func sessionCacheKey(serverURL *url.URL, globalAccountSubdomain string, identityProvider string, username string) string {
+	if len(username) == 0 {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join([]string{serverURL.String(), globalAccountSubdomain, identityProvider, username}, "\n")))
+
+	return hex.EncodeToString(hash[:])
+}
+
+func (c *SessionCache) path(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+// load returns the cached session for the given key, if any
+func (c *SessionCache) load(key string) (*Session, bool) {
+	content, err := os.ReadFile(c.path(key))
+
+	if err != nil {
+		return nil, false
+	}
+
+	var cached cachedSession
+	if err := json.Unmarshal(content, &cached); err != nil || len(cached.SessionId) == 0 {
+		return nil, false
+	}
+
+	return &Session{
+		GlobalAccountSubdomain: cached.GlobalAccountSubdomain,
+		SessionId:              cached.SessionId,
+		IdentityProvider:       cached.IdentityProvider,
+		LoggedInUser: &v2LoggedInUser{
+			Username: cached.Username,
+			Email:    cached.Email,
+			Issuer:   cached.Issuer,
+		},
+	}, true
+}
+
+// store writes the given session to the cache. The file is replaced atomically, so that concurrent provider
+// instances never read a partially written session.
+func (c *SessionCache) store(key string, session *Session) error {
+	cached := cachedSession{
+		GlobalAccountSubdomain: session.GlobalAccountSubdomain,
+		SessionId:              session.SessionId,
+		IdentityProvider:       session.IdentityProvider,
+	}
+
+	if session.LoggedInUser != nil {
+		cached.Username = session.LoggedInUser.Username
+		cached.Email = session.LoggedInUser.Email
+		cached.Issuer = session.LoggedInUser.Issuer
+	}
+
+	content, err := json.Marshal(cached)
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(c.dir, key+".*.tmp")
+
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	// os.CreateTemp already restricts the permissions to the owner, this only guards against a permissive umask
+	if err := os.Chmod(file.Name(), 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), c.path(key))
+}
+
+// remove deletes the cached session for the given key
+func (c *SessionCache) remove(key string) error {
+	if err := os.Remove(c.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package btpcli
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionCacheKey(t *testing.T) {
+	t.Parallel()
+
+	serverURL, _ := url.Parse("https://cli.btp.cloud.sap")
+	otherServerURL, _ := url.Parse("https://canary.cli.btp.int.sap")
+
+	key := sessionCacheKey(serverURL, "subdomain", "idp", "john.doe")
+
+	assert.Len(t, key, 64)
+	assert.Equal(t, key, sessionCacheKey(serverURL, "subdomain", "idp", "john.doe"))
+	assert.NotEqual(t, key, sessionCacheKey(otherServerURL, "subdomain", "idp", "john.doe"))
+	assert.NotEqual(t, key, sessionCacheKey(serverURL, "other-subdomain", "idp", "john.doe"))
+	assert.NotEqual(t, key, sessionCacheKey(serverURL, "subdomain", "", "john.doe"))
+	assert.NotEqual(t, key, sessionCacheKey(serverURL, "subdomain", "idp", "jane.doe"))
+	assert.Empty(t, sessionCacheKey(serverURL, "subdomain", "idp", ""))
+}
+
+func TestSessionCache(t *testing.T) {
+	t.Parallel()
+
+	session := &Session{
+		GlobalAccountSubdomain: "subdomain",
+		SessionId:              "session-1",
+		IdentityProvider:       "idp",
+		LoggedInUser: &v2LoggedInUser{
+			Username: "john.doe",
+			Email:    "john.doe@test.com",
+			Issuer:   "accounts.sap.com",
+		},
+	}
+
+	t.Run("happy path - store and load", func(t *testing.T) {
+		uut := NewSessionCache(filepath.Join(t.TempDir(), "sessions"))
+
+		assert.NoError(t, uut.store("key", session))
+
+		cached, found := uut.load("key")
+
+		if assert.True(t, found) {
+			assert.Equal(t, session.GlobalAccountSubdomain, cached.GlobalAccountSubdomain)
+			assert.Equal(t, session.SessionId, cached.SessionId)
+			assert.Equal(t, session.IdentityProvider, cached.IdentityProvider)
+			assert.Equal(t, session.LoggedInUser, cached.LoggedInUser)
+		}
+	})
+	t.Run("happy path - access is restricted to the owner", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("file permissions are not supported on windows")
+		}
+
+		dir := filepath.Join(t.TempDir(), "sessions")
+		uut := NewSessionCache(dir)
+
+		assert.NoError(t, uut.store("key", session))
+
+		dirInfo, err := os.Stat(dir)
+		if assert.NoError(t, err) {
+			assert.Equal(t, os.FileMode(0700), dirInfo.Mode().Perm())
+		}
+
+		fileInfo, err := os.Stat(uut.path("key"))
+		if assert.NoError(t, err) {
+			assert.Equal(t, os.FileMode(0600), fileInfo.Mode().Perm())
+		}
+	})
+	t.Run("happy path - remove", func(t *testing.T) {
+		uut := NewSessionCache(t.TempDir())
+
+		assert.NoError(t, uut.store("key", session))
+		assert.NoError(t, uut.remove("key"))
+		assert.NoError(t, uut.remove("key"))
+
+		_, found := uut.load("key")
+		assert.False(t, found)
+	})
+	t.Run("error path - nothing cached", func(t *testing.T) {
+		uut := NewSessionCache(t.TempDir())
+
+		_, found := uut.load("key")
+
+		assert.False(t, found)
+	})
+	t.Run("error path - corrupt cache file", func(t *testing.T) {
+		uut := NewSessionCache(t.TempDir())
+		assert.NoError(t, os.WriteFile(uut.path("key"), []byte("{"), 0600))
+
+		_, found := uut.load("key")
+
+		assert.False(t, found)
+	})
+}
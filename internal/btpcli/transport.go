@@ -1,9 +1,134 @@
 package btpcli
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// LogSubsystem is the name of the tflog subsystem used to log the communication with the CLI server. Its log level
+// can be set independently via the TF_LOG_PROVIDER_BTP_CLI environment variable.
+const LogSubsystem string = "btp_cli"
+
+const redactedValue string = "redacted"
+
+// sensitiveKeyFragments are matched against lower-cased JSON keys to determine which values must not be logged, in
+// line with the redaction of the recorded test fixtures
+var sensitiveKeyFragments = []string{
+	"password",
+	"token",
+	"session",
+	"secret",
+	"clientid",
+	"client_id",
+	"username",
+	"credentials",
+	"key",
+	"authorization",
+	"cookie",
+}
+
+// loggedHeaders are the only headers whose values are logged, all other headers are redacted
+var loggedHeaders = []string{
+	"Accept",
+	"Content-Length",
+	"Content-Type",
+	"Date",
+	"User-Agent",
+	HeaderCorrelationID,
+	HeaderCLIFormat,
+	HeaderCLISubdomain,
+	HeaderCLICustomIDP,
+	HeaderCLIBackendStatus,
+	HeaderCLIBackendMessage,
+	HeaderCLIBackendMediaType,
+	HeaderCLIClientUpdate,
+	HeaderCLIServerMessage,
+}
+
+// TransportConfig contains the connection settings which apply to the CLI server as well as to the identity provider.
+// Client certificates are not part of it, as the CLI server authenticates by sessions only. The certificate of the
+// x509 authentication is presented to the identity provider by the passcode login.
+type TransportConfig struct {
+	// ProxyURL overrides the proxy determined by the HTTPS_PROXY and NO_PROXY environment variables
+	ProxyURL *url.URL
+
+	// PEMEncodedCACerts are trusted in addition to the system cert pool
+	PEMEncodedCACerts string
+
+	// MinTLSVersion is the minimum TLS version accepted, e.g. tls.VersionTLS13. Defaults to TLS 1.2.
+	MinTLSVersion uint16
+}
+
+// NewTransport creates a new http.Transport based on the http.DefaultTransport with the given connection settings applied
+func (c TransportConfig) NewTransport() (*http.Transport, error) {
+	return c.applyTo(http.DefaultTransport.(*http.Transport))
+}
+
+// WrapTransport applies the connection settings to a copy of the given transport, so that the settings of the caller
+// are kept. Round trippers which are not an http.Transport, e.g. the recorder of the tests, can't be configured and are
+// returned as they are, as they are in charge of the connection themselves.
+func (c TransportConfig) WrapTransport(parent http.RoundTripper) (http.RoundTripper, error) {
+	switch transport := parent.(type) {
+	case nil:
+		return c.NewTransport()
+	case *http.Transport:
+		return c.applyTo(transport)
+	default:
+		if _, err := c.applyTo(http.DefaultTransport.(*http.Transport)); err != nil {
+			return nil, err
+		}
+
+		return parent, nil
+	}
+}
+
+// applyTo returns a clone of the given transport with the connection settings applied
+func (c TransportConfig) applyTo(parent *http.Transport) (*http.Transport, error) {
+	transport := parent.Clone()
+
+	if c.ProxyURL != nil {
+		transport.Proxy = http.ProxyURL(c.ProxyURL)
+	}
+
+	tlsConfig := &tls.Config{}
+	if transport.TLSClientConfig != nil {
+		tlsConfig = transport.TLSClientConfig
+	}
+
+	tlsConfig.MinVersion = max(c.MinTLSVersion, tlsConfig.MinVersion, tls.VersionTLS12)
+
+	if len(c.PEMEncodedCACerts) > 0 {
+		caCertPool, err := x509.SystemCertPool()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !caCertPool.AppendCertsFromPEM([]byte(c.PEMEncodedCACerts)) {
+			return nil, fmt.Errorf("no valid PEM encoded CA certificate found")
+		}
+
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
 // injectBTPCLITransport wraps the transport of the given client with the btpcliTransport
 func injectBTPCLITransport(client *http.Client) *http.Client {
 	parentTransport := http.DefaultTransport
@@ -17,7 +142,7 @@ func injectBTPCLITransport(client *http.Client) *http.Client {
 }
 
 // btpcliTransport implements the http.RoundTripper interface. Its purpose is to copy headers from parent responses (in case of redirects)
-// to the actual request.
+// to the actual request and to log the communication with the CLI server.
 type btpcliTransport struct {
 	transport http.RoundTripper
 }
@@ -37,5 +162,180 @@ func (bt *btpcliTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	bt.copyResponseHeaderToRequestHeader(req, HeaderCLISubdomain, HeaderCLISubdomain)
 	bt.copyResponseHeaderToRequestHeader(req, HeaderIDToken, HeaderIDToken)
 
-	return bt.transport.RoundTrip(req)
+	ctx := tflog.NewSubsystem(req.Context(), LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_BTP_CLI"), tflog.WithRootFields())
+	ctx = logRequest(ctx, req)
+
+	start := time.Now()
+	res, err := bt.transport.RoundTrip(req)
+
+	logResponse(ctx, res, err, time.Since(start))
+
+	return res, err
+}
+
+// logRequest logs the command of the request and returns a context which carries the request details as log fields
+func logRequest(ctx context.Context, req *http.Request) context.Context {
+	command, action := commandFromURL(req)
+	body := requestBody(req)
+
+	ctx = tflog.SubsystemSetField(ctx, LogSubsystem, "command", command)
+	ctx = tflog.SubsystemSetField(ctx, LogSubsystem, "action", action)
+	ctx = tflog.SubsystemSetField(ctx, LogSubsystem, "correlation_id", req.Header.Get(HeaderCorrelationID))
+
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending request to BTP CLI server", map[string]interface{}{
+		"parameters": parameterNames(body),
+	})
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Request details", map[string]interface{}{
+		"url":     req.URL.String(),
+		"headers": redactHeaders(req.Header),
+		"body":    redactBody(body),
+	})
+
+	return ctx
+}
+
+// logResponse logs the outcome of a request
+func logResponse(ctx context.Context, res *http.Response, err error, duration time.Duration) {
+	if err != nil {
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Request to BTP CLI server failed", map[string]interface{}{
+			"error":       err.Error(),
+			"duration_ms": duration.Milliseconds(),
+		})
+		return
+	}
+
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received response from BTP CLI server", map[string]interface{}{
+		"status":         res.StatusCode,
+		"backend_status": res.Header.Get(HeaderCLIBackendStatus),
+		"duration_ms":    duration.Milliseconds(),
+	})
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Response details", map[string]interface{}{
+		"headers": redactHeaders(res.Header),
+	})
+}
+
+// commandFromURL determines the command and action of a request, e.g. `accounts/subaccount` and `get`. Requests which are not
+// commands (e.g. logins) are reported by their path.
+func commandFromURL(req *http.Request) (command string, action string) {
+	segments := strings.SplitN(strings.TrimPrefix(req.URL.Path, "/"), "/", 3)
+
+	if len(segments) == 3 && segments[0] == "command" {
+		return segments[2], req.URL.RawQuery
+	}
+
+	return strings.TrimPrefix(req.URL.Path, "/"), ""
+}
+
+// requestBody decodes the JSON body of a request without consuming it. Command parameters are unwrapped. Bodies of other
+// content types, e.g. the form data of a login, are not decoded.
+func requestBody(req *http.Request) map[string]any {
+	if req.GetBody == nil || !isJSONContent(req.Header) {
+		return nil
+	}
+
+	reader, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer reader.Close()
+
+	var body map[string]any
+	if err := json.NewDecoder(io.LimitReader(reader, 1<<20)).Decode(&body); err != nil {
+		return nil
+	}
+
+	if params, isCommand := body["paramValues"].(map[string]any); isCommand {
+		return params
+	}
+
+	return body
+}
+
+// parameterNames returns the sorted names of the given parameters
+func parameterNames(params map[string]any) []string {
+	names := make([]string, 0, len(params))
+
+	for name := range params {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// isSensitiveKey determines whether the value of the given parameter must be redacted
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(key, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isJSONContent determines whether the content type of the given headers is JSON
+func isJSONContent(headers http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(headers.Get("Content-Type"))
+
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// redactHeaders returns a copy of the given headers in which the values of all headers which are not known to be
+// harmless are redacted
+func redactHeaders(headers http.Header) map[string]string {
+	redacted := make(map[string]string, len(headers))
+
+	for key, values := range headers {
+		if slices.Contains(loggedHeaders, http.CanonicalHeaderKey(key)) {
+			redacted[key] = strings.Join(values, ", ")
+		} else {
+			redacted[key] = redactedValue
+		}
+	}
+
+	return redacted
+}
+
+// redactBody returns a copy of the given body in which all sensitive values are redacted. Strings containing JSON
+// objects, e.g. the parameters of service instances and bindings, are redacted as well.
+func redactBody(body map[string]any) map[string]any {
+	if body == nil {
+		return nil
+	}
+
+	redacted := make(map[string]any, len(body))
+
+	for key, value := range body {
+		if isSensitiveKey(key) {
+			redacted[key] = redactedValue
+		} else {
+			redacted[key] = redactValue(value)
+		}
+	}
+
+	return redacted
+}
+
+func redactValue(value any) any {
+	switch val := value.(type) {
+	case map[string]any:
+		return redactBody(val)
+	case []any:
+		redacted := make([]any, len(val))
+		for i, elem := range val {
+			redacted[i] = redactValue(elem)
+		}
+		return redacted
+	case string:
+		var nested map[string]any
+		if strings.HasPrefix(strings.TrimSpace(val), "{") && json.Unmarshal([]byte(val), &nested) == nil {
+			return redactBody(nested)
+		}
+		return val
+	default:
+		return val
+	}
 }
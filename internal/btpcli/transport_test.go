@@ -1,11 +1,20 @@
 package btpcli
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/pem"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -101,3 +110,277 @@ func TestBTPCLITransport(t *testing.T) {
 		})
 	}
 }
+
+func TestBTPCLITransport_Logging(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(HeaderCLIBackendStatus, "200")
+		w.Header().Set(HeaderCLISessionId, "response-session-id")
+		fmt.Fprintf(w, "{}")
+	}))
+	defer srv.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	srvUrl, _ := url.Parse(srv.URL)
+	uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl)
+	uut.session = &Session{SessionId: "secret-session-id", GlobalAccountSubdomain: "my-subdomain"}
+	uut.newCorrelationID = func() string {
+		return "fake-correlation-id"
+	}
+
+	_, err := uut.Execute(ctx, NewCreateRequest("services/binding", map[string]string{
+		"subaccount": "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f",
+		"name":       "my-binding",
+		"parameters": `{"password":"secret-password","nested":{"client_secret":"secret-client-secret"},"plain":"value"}`,
+		"privateKey": "secret-private-key",
+	}))
+	assert.NoError(t, err)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+
+	if assert.Len(t, entries, 4) {
+		assert.Equal(t, "Sending request to BTP CLI server", entries[0]["@message"])
+		assert.Equal(t, "services/binding", entries[0]["command"])
+		assert.Equal(t, "create", entries[0]["action"])
+		assert.Equal(t, "fake-correlation-id", entries[0]["correlation_id"])
+		assert.Equal(t, []any{"name", "parameters", "privateKey", "subaccount"}, entries[0]["parameters"])
+
+		assert.Equal(t, "Request details", entries[1]["@message"])
+		assert.Equal(t, map[string]any{
+			"name":       "my-binding",
+			"parameters": map[string]any{"password": "redacted", "nested": map[string]any{"client_secret": "redacted"}, "plain": "value"},
+			"privateKey": "redacted",
+			"subaccount": "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f",
+		}, entries[1]["body"])
+		assert.Equal(t, "redacted", entries[1]["headers"].(map[string]any)[HeaderCLISessionId])
+		assert.Equal(t, "my-subdomain", entries[1]["headers"].(map[string]any)[HeaderCLISubdomain])
+
+		assert.Equal(t, "Received response from BTP CLI server", entries[2]["@message"])
+		assert.Equal(t, float64(http.StatusOK), entries[2]["status"])
+		assert.Equal(t, "200", entries[2]["backend_status"])
+		assert.Contains(t, entries[2], "duration_ms")
+		assert.Equal(t, "services/binding", entries[2]["command"])
+
+		assert.Equal(t, "Response details", entries[3]["@message"])
+		assert.Equal(t, "redacted", entries[3]["headers"].(map[string]any)[HeaderCLISessionId])
+	}
+
+	assert.NotContains(t, output.String(), "secret-")
+}
+
+func TestBTPCLITransport_RedactHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Content-Type", "application/json")
+	headers.Set(HeaderCLISubdomain, "my-subdomain")
+	headers.Set(HeaderCLIBackendStatus, "200")
+	headers.Set(HeaderCLISessionId, "secret-session-id")
+	headers.Set("Authorization", "Bearer secret-token")
+	headers.Set("X-Custom-Header", "unknown-value")
+	headers["x-cpcli-format"] = []string{"json"}
+
+	assert.Equal(t, map[string]string{
+		"Content-Type":         "application/json",
+		HeaderCLISubdomain:     "my-subdomain",
+		HeaderCLIBackendStatus: "200",
+		HeaderCLISessionId:     "redacted",
+		"Authorization":        "redacted",
+		"X-Custom-Header":      "redacted",
+		"x-cpcli-format":       "json",
+	}, redactHeaders(headers))
+}
+
+func TestBTPCLITransport_RequestBody(t *testing.T) {
+	tests := []struct {
+		description string
+		contentType string
+		body        string
+		expects     map[string]any
+	}{
+		{
+			description: "command parameters are unwrapped",
+			contentType: "application/json",
+			body:        `{"paramValues":{"subaccount":"my-subaccount"}}`,
+			expects:     map[string]any{"subaccount": "my-subaccount"},
+		},
+		{
+			description: "json with charset",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"customIdp":"my-idp"}`,
+			expects:     map[string]any{"customIdp": "my-idp"},
+		},
+		{
+			description: "form data is skipped",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "username=john.doe&password=secret",
+		},
+		{
+			description: "missing content type is skipped",
+			body:        `{"paramValues":{"subaccount":"my-subaccount"}}`,
+		},
+		{
+			description: "invalid json is skipped",
+			contentType: "application/json",
+			body:        "not json",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "https://cli.btp.cloud.sap/command/v2.49.0/accounts/subaccount?get", strings.NewReader(test.body))
+			if len(test.contentType) > 0 {
+				req.Header.Set("Content-Type", test.contentType)
+			}
+
+			assert.Equal(t, test.expects, requestBody(req))
+		})
+	}
+}
+
+func TestBTPCLITransport_CommandFromURL(t *testing.T) {
+	tests := []struct {
+		url            string
+		expectsCommand string
+		expectsAction  string
+	}{
+		{url: "https://cli.btp.cloud.sap/command/v2.49.0/accounts/subaccount?get", expectsCommand: "accounts/subaccount", expectsAction: "get"},
+		{url: "https://cli.btp.cloud.sap/command/v2.49.0/security/role-collection?list", expectsCommand: "security/role-collection", expectsAction: "list"},
+		{url: "https://cli.btp.cloud.sap/login/v2.49.0", expectsCommand: "login/v2.49.0", expectsAction: ""},
+		{url: "https://cli.btp.cloud.sap/login/v2.49.0/idtoken", expectsCommand: "login/v2.49.0/idtoken", expectsAction: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.url, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, test.url, nil)
+
+			command, action := commandFromURL(req)
+
+			assert.Equal(t, test.expectsCommand, command)
+			assert.Equal(t, test.expectsAction, action)
+		})
+	}
+}
+
+func TestTransportConfig_NewTransport(t *testing.T) {
+	t.Parallel()
+	t.Run("defaults", func(t *testing.T) {
+		transport, err := TransportConfig{}.NewTransport()
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, uint16(tls.VersionTLS12), transport.TLSClientConfig.MinVersion)
+			assert.Nil(t, transport.TLSClientConfig.RootCAs)
+		}
+	})
+	t.Run("proxy url and tls version", func(t *testing.T) {
+		proxyURL, _ := url.Parse("http://proxy.corp.local:3128")
+
+		transport, err := TransportConfig{ProxyURL: proxyURL, MinTLSVersion: tls.VersionTLS13}.NewTransport()
+
+		if assert.NoError(t, err) {
+			req, _ := http.NewRequest(http.MethodPost, "https://cli.btp.cloud.sap", nil)
+			usedProxyURL, _ := transport.Proxy(req)
+
+			assert.Equal(t, proxyURL, usedProxyURL)
+			assert.Equal(t, uint16(tls.VersionTLS13), transport.TLSClientConfig.MinVersion)
+		}
+	})
+	t.Run("invalid ca certificate", func(t *testing.T) {
+		_, err := TransportConfig{PEMEncodedCACerts: "not a certificate"}.NewTransport()
+
+		assert.EqualError(t, err, "no valid PEM encoded CA certificate found")
+	})
+	t.Run("trusts the given ca certificate", func(t *testing.T) {
+		srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(HeaderCLIBackendStatus, "200")
+			fmt.Fprintf(w, "{}")
+		}))
+		defer srv.Close()
+
+		srvUrl, _ := url.Parse(srv.URL)
+		pemEncodedCACert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+		untrustingClient, err := NewV2ClientWithTransportConfig(http.DefaultClient, TransportConfig{}, srvUrl)
+		if assert.NoError(t, err) {
+			_, err = untrustingClient.Execute(context.TODO(), NewGetRequest("subaccount/role", map[string]string{}))
+			assert.ErrorContains(t, err, "certificate")
+		}
+
+		trustingClient, err := NewV2ClientWithTransportConfig(http.DefaultClient, TransportConfig{PEMEncodedCACerts: pemEncodedCACert}, srvUrl)
+		if assert.NoError(t, err) {
+			_, err = trustingClient.Execute(context.TODO(), NewGetRequest("subaccount/role", map[string]string{}))
+			assert.NoError(t, err)
+		}
+	})
+	t.Run("keeps the settings of the given transport", func(t *testing.T) {
+		parent := &http.Transport{MaxIdleConns: 7, TLSClientConfig: &tls.Config{ServerName: "cli.btp.int"}}
+
+		transport, err := TransportConfig{MinTLSVersion: tls.VersionTLS13}.WrapTransport(parent)
+
+		if assert.NoError(t, err) && assert.IsType(t, &http.Transport{}, transport) {
+			assert.Equal(t, 7, transport.(*http.Transport).MaxIdleConns)
+			assert.Equal(t, "cli.btp.int", transport.(*http.Transport).TLSClientConfig.ServerName)
+			assert.Equal(t, uint16(tls.VersionTLS13), transport.(*http.Transport).TLSClientConfig.MinVersion)
+			assert.Equal(t, uint16(0), parent.TLSClientConfig.MinVersion)
+		}
+	})
+	t.Run("still calls an injected round tripper", func(t *testing.T) {
+		proxyURL, _ := url.Parse("http://proxy.corp.local:3128")
+		srvUrl, _ := url.Parse("https://cli.btp.int")
+
+		tlsSrv := httptest.NewTLSServer(nil)
+		defer tlsSrv.Close()
+
+		configs := map[string]TransportConfig{
+			"proxy url":      {ProxyURL: proxyURL},
+			"ca certificate": {PEMEncodedCACerts: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsSrv.Certificate().Raw}))},
+			"tls version":    {MinTLSVersion: tls.VersionTLS13},
+		}
+
+		for name, config := range configs {
+			t.Run(name, func(t *testing.T) {
+				var calls int
+				roundTripper := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					calls++
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     http.Header{HeaderCLIBackendStatus: []string{"200"}},
+						Body:       io.NopCloser(strings.NewReader("{}")),
+						Request:    req,
+					}, nil
+				})
+
+				uut, err := NewV2ClientWithTransportConfig(&http.Client{Transport: roundTripper}, config, srvUrl)
+
+				if assert.NoError(t, err) {
+					_, err = uut.Execute(context.TODO(), NewGetRequest("subaccount/role", map[string]string{}))
+					assert.NoError(t, err)
+					assert.Equal(t, 1, calls)
+				}
+			})
+		}
+	})
+	t.Run("validates the settings of an injected round tripper", func(t *testing.T) {
+		_, err := TransportConfig{PEMEncodedCACerts: "not a certificate"}.WrapTransport(roundTripperFunc(nil))
+
+		assert.EqualError(t, err, "no valid PEM encoded CA certificate found")
+	})
+	t.Run("keeps the settings of the given http client", func(t *testing.T) {
+		srvUrl, _ := url.Parse("https://cli.btp.int")
+		httpClient := &http.Client{Timeout: 42 * time.Second}
+
+		uut, err := NewV2ClientWithTransportConfig(httpClient, TransportConfig{MinTLSVersion: tls.VersionTLS13}, srvUrl)
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, 42*time.Second, uut.httpClient.Timeout)
+			assert.NotNil(t, uut.httpClient.Transport)
+			assert.Nil(t, httpClient.Transport)
+		}
+	})
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
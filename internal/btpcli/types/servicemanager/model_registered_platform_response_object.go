@@ -28,5 +28,5 @@ type RegisteredPlatformResponseObject struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// The last time the platform was updated. <br/>In ISO 8601 format. </br>
 	UpdatedAt time.Time            `json:"updated_at,omitempty"`
-	Labels    *map[string][]string `json:"labels,omitempty"`
+	Labels    ServiceManagerLabels `json:"labels,omitempty"`
 }
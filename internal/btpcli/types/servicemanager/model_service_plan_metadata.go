@@ -17,4 +17,6 @@ type ServicePlanMetadata struct {
 	SupportedMinOSBVersion json.Number `json:"supportedMinOSBVersion,omitempty"`
 	// The latest supported OSB version.
 	SupportedMaxOSBVersion json.Number `json:"supportedMaxOSBVersion,omitempty"`
+	// Whether instances of the service plan can be shared with other environments.
+	SupportsInstanceSharing bool `json:"supportsInstanceSharing,omitempty"`
 }
@@ -0,0 +1,33 @@
+package xsuaa_api
+
+// ApiCredential OAuth client credentials to access the APIs of a subaccount
+type ApiCredential struct {
+
+	// name of the API credential
+	Name string `json:"name,omitempty"`
+
+	// client Id
+	ClientID string `json:"clientid,omitempty"`
+
+	// client secret, only returned on creation of a secret based API credential
+	ClientSecret string `json:"clientsecret,omitempty"`
+
+	// certificate, only returned on creation of a certificate based API credential
+	Certificate string `json:"certificate,omitempty"`
+
+	// private key of the certificate, only returned if the certificate has been generated
+	Key string `json:"key,omitempty"`
+
+	// URL of the authorization server to get a token
+	TokenURL string `json:"tokenurl,omitempty"`
+
+	// URL of the APIs to access with the obtained token
+	APIURL string `json:"apiurl,omitempty"`
+
+	// whether the API credential grants read-only access
+	ReadOnly bool `json:"read-only,omitempty"`
+
+	// credential type
+	// Enum: [binding-secret x509]
+	CredentialType string `json:"credential-type,omitempty"`
+}
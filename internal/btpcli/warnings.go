@@ -0,0 +1,76 @@
+package btpcli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// serverWarnings collects the announcements of the CLI server, e.g. deprecations or pending protocol changes, so that
+// they can be reported to the user. Every announcement is reported only once.
+type serverWarnings struct {
+	sync.Mutex
+
+	seen    map[string]bool
+	pending []string
+}
+
+func newServerWarnings() *serverWarnings {
+	return &serverWarnings{seen: map[string]bool{}}
+}
+
+// collect records the announcements of a successful response. The server messages of failed commands are part of the
+// returned error instead.
+func (w *serverWarnings) collect(ctx context.Context, res *http.Response) {
+	if res.StatusCode >= 400 {
+		return
+	}
+
+	if backendStatus, err := strconv.Atoi(res.Header.Get(HeaderCLIBackendStatus)); err == nil && backendStatus >= 400 {
+		return
+	}
+
+	var warnings []string
+
+	if clientUpdate := strings.TrimSpace(res.Header.Get(HeaderCLIClientUpdate)); len(clientUpdate) > 0 && !strings.EqualFold(clientUpdate, "false") {
+		if strings.EqualFold(clientUpdate, "true") {
+			warnings = append(warnings, "The BTP CLI server reports that the provider uses an outdated client protocol. Update the provider to the latest version.")
+		} else {
+			warnings = append(warnings, fmt.Sprintf("The BTP CLI server reports that the provider uses an outdated client protocol: %s", clientUpdate))
+		}
+	}
+
+	if serverMessage := strings.TrimSpace(res.Header.Get(HeaderCLIServerMessage)); len(serverMessage) > 0 {
+		warnings = append(warnings, serverMessage)
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	for _, warning := range warnings {
+		if w.seen[warning] {
+			continue
+		}
+
+		w.seen[warning] = true
+		w.pending = append(w.pending, warning)
+
+		tflog.Warn(ctx, "BTP CLI server announcement", map[string]interface{}{"message": warning})
+	}
+}
+
+// take returns the announcements which have not been reported so far
+func (w *serverWarnings) take() []string {
+	w.Lock()
+	defer w.Unlock()
+
+	pending := w.pending
+	w.pending = nil
+
+	return pending
+}
@@ -0,0 +1,86 @@
+package btpcli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerWarnings(t *testing.T) {
+	t.Parallel()
+
+	response := func(status int, backendStatus string, headers map[string]string) *http.Response {
+		res := &http.Response{StatusCode: status, Header: http.Header{}}
+		res.Header.Set(HeaderCLIBackendStatus, backendStatus)
+
+		for key, value := range headers {
+			res.Header.Set(key, value)
+		}
+
+		return res
+	}
+
+	t.Run("happy path - announcements are reported once", func(t *testing.T) {
+		uut := newServerWarnings()
+
+		uut.collect(context.TODO(), response(http.StatusOK, "200", map[string]string{HeaderCLIServerMessage: "Protocol v2.49.0 is deprecated."}))
+		uut.collect(context.TODO(), response(http.StatusOK, "200", map[string]string{HeaderCLIServerMessage: "Protocol v2.49.0 is deprecated."}))
+
+		assert.Equal(t, []string{"Protocol v2.49.0 is deprecated."}, uut.take())
+
+		uut.collect(context.TODO(), response(http.StatusOK, "200", map[string]string{HeaderCLIServerMessage: "Protocol v2.49.0 is deprecated."}))
+
+		assert.Empty(t, uut.take())
+	})
+	t.Run("happy path - client update", func(t *testing.T) {
+		uut := newServerWarnings()
+
+		uut.collect(context.TODO(), response(http.StatusOK, "", map[string]string{HeaderCLIClientUpdate: "true"}))
+		uut.collect(context.TODO(), response(http.StatusOK, "", map[string]string{HeaderCLIClientUpdate: "2.54.0"}))
+
+		assert.Equal(t, []string{
+			"The BTP CLI server reports that the provider uses an outdated client protocol. Update the provider to the latest version.",
+			"The BTP CLI server reports that the provider uses an outdated client protocol: 2.54.0",
+		}, uut.take())
+	})
+	t.Run("happy path - no announcements", func(t *testing.T) {
+		uut := newServerWarnings()
+
+		uut.collect(context.TODO(), response(http.StatusOK, "200", map[string]string{HeaderCLIClientUpdate: "false"}))
+
+		assert.Empty(t, uut.take())
+	})
+	t.Run("error path - messages of failed commands are ignored", func(t *testing.T) {
+		uut := newServerWarnings()
+
+		uut.collect(context.TODO(), response(http.StatusOK, "403", map[string]string{HeaderCLIServerMessage: "Directory lacks AUTHORIZATIONS feature."}))
+		uut.collect(context.TODO(), response(http.StatusBadRequest, "", map[string]string{HeaderCLIServerMessage: "Invalid parameters."}))
+
+		assert.Empty(t, uut.take())
+	})
+}
+
+func TestV2Client_TakeServerWarnings(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(HeaderCLIServerMessage, "Login with subdomain will be removed.")
+		w.Header().Set(HeaderCLISessionId, "session-1")
+		fmt.Fprintf(w, `{"issuer": "accounts.sap.com","user":"john.doe","mail":"john.doe@test.com"}`)
+	}))
+	defer srv.Close()
+
+	srvUrl, _ := url.Parse(srv.URL)
+	uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl)
+
+	_, err := uut.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"Login with subdomain will be removed."}, uut.TakeServerWarnings())
+	assert.Empty(t, uut.TakeServerWarnings())
+}
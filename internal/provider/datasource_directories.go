@@ -83,6 +83,8 @@ You must be assigned to the admin or viewer role of the global account, director
 }
 
 func (ds *directoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data directoriesType
 
 	diags := req.Config.Get(ctx, &data)
@@ -94,7 +96,7 @@ func (ds *directoriesDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	gaRes, _, err := ds.cli.Accounts.GlobalAccount.GetWithHierarchy(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Directories", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Directories", errorDetail(err))
 		return
 	}
 
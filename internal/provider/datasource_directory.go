@@ -126,6 +126,8 @@ __Further documentation:__
 }
 
 func (ds *directoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data directoryType
 
 	diags := req.Config.Get(ctx, &data)
@@ -137,7 +139,7 @@ func (ds *directoryDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	cliRes, _, err := ds.cli.Accounts.Directory.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Directory", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Directory", errorDetail(err))
 		return
 	}
 
@@ -146,6 +146,8 @@ func (ds *directoryAppDataSource) Schema(_ context.Context, _ datasource.SchemaR
 }
 
 func (ds *directoryAppDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data directoryAppDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -157,7 +159,7 @@ func (ds *directoryAppDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	cliRes, _, err := ds.cli.Security.App.GetByDirectory(ctx, data.DirectoryId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource App (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource App (Directory)", errorDetail(err))
 		return
 	}
 
@@ -153,6 +153,8 @@ func (ds *directoryAppsDataSource) Schema(_ context.Context, _ datasource.Schema
 }
 
 func (ds *directoryAppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data directoryAppsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -164,7 +166,7 @@ func (ds *directoryAppsDataSource) Read(ctx context.Context, req datasource.Read
 
 	cliRes, _, err := ds.cli.Security.App.ListByDirectory(ctx, data.DirectoryId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Apps (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Apps (Directory)", errorDetail(err))
 		return
 	}
 
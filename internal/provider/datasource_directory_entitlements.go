@@ -114,6 +114,8 @@ To view all the resources that a directory and its subdirectories and subaccount
 }
 
 func (ds *directoryEntitlementsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data directoryEntitlementsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -125,7 +127,7 @@ func (ds *directoryEntitlementsDataSource) Read(ctx context.Context, req datasou
 
 	cliRes, _, err := ds.cli.Accounts.Entitlement.ListByDirectory(ctx, data.DirectoryId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Entitlements (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Entitlements (Directory)", errorDetail(err))
 		return
 	}
 
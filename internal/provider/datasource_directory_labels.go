@@ -73,6 +73,8 @@ __Further documentation:__
 }
 
 func (ds *directoryLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data directoryLabelsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -84,7 +86,7 @@ func (ds *directoryLabelsDataSource) Read(ctx context.Context, req datasource.Re
 
 	cliRes, _, err := ds.cli.Accounts.Label.ListByDirectory(ctx, data.DirectoryId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Labels (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Labels (Directory)", errorDetail(err))
 		return
 	}
 
@@ -116,6 +116,8 @@ func (ds *directoryRoleDataSource) Schema(_ context.Context, _ datasource.Schema
 }
 
 func (ds *directoryRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data directoryRoleType
 
 	diags := req.Config.Get(ctx, &data)
@@ -127,7 +129,7 @@ func (ds *directoryRoleDataSource) Read(ctx context.Context, req datasource.Read
 
 	cliRes, _, err := ds.cli.Security.Role.GetByDirectory(ctx, data.DirectoryId.ValueString(), data.Name.ValueString(), data.RoleTemplateAppId.ValueString(), data.RoleTemplateName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role (Directory)", errorDetail(err))
 		return
 	}
 
@@ -112,6 +112,8 @@ func (ds *directoryRoleCollectionDataSource) Schema(_ context.Context, _ datasou
 }
 
 func (ds *directoryRoleCollectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data directoryRoleCollectionDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -123,7 +125,7 @@ func (ds *directoryRoleCollectionDataSource) Read(ctx context.Context, req datas
 
 	cliRes, _, err := ds.cli.Security.RoleCollection.GetByDirectory(ctx, data.DirectoryId.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role Collection (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role Collection (Directory)", errorDetail(err))
 		return
 	}
 
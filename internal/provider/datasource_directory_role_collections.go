@@ -119,6 +119,8 @@ func (ds *directoryRoleCollectionsDataSource) Schema(_ context.Context, _ dataso
 }
 
 func (ds *directoryRoleCollectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data directoryRoleCollectionsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -130,7 +132,7 @@ func (ds *directoryRoleCollectionsDataSource) Read(ctx context.Context, req data
 
 	cliRes, _, err := ds.cli.Security.RoleCollection.ListByDirectory(ctx, data.DirectoryId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role Collections (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role Collections (Directory)", errorDetail(err))
 		return
 	}
 
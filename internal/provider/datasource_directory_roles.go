@@ -139,6 +139,8 @@ __Further documentation:__
 }
 
 func (ds *directoryRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data directoryRolesDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -150,7 +152,7 @@ func (ds *directoryRolesDataSource) Read(ctx context.Context, req datasource.Rea
 
 	cliRes, _, err := ds.cli.Security.Role.ListByDirectory(ctx, data.DirectoryId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Roles (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Roles (Directory)", errorDetail(err))
 		return
 	}
 
@@ -109,6 +109,8 @@ func (ds *directoryUserDataSource) Schema(_ context.Context, _ datasource.Schema
 }
 
 func (ds *directoryUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data directoryUserDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -124,7 +126,7 @@ func (ds *directoryUserDataSource) Read(ctx context.Context, req datasource.Read
 
 	cliRes, _, err := ds.cli.Security.User.GetByDirectory(ctx, data.DirectoryId.ValueString(), data.UserName.ValueString(), data.Origin.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource User (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource User (Directory)", errorDetail(err))
 		return
 	}
 
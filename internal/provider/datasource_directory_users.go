@@ -79,6 +79,8 @@ __Further documentation:__
 }
 
 func (ds *directoryUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data directoryUsersDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -94,7 +96,7 @@ func (ds *directoryUsersDataSource) Read(ctx context.Context, req datasource.Rea
 
 	cliRes, _, err := ds.cli.Security.User.ListByDirectory(ctx, data.DirectoryId.ValueString(), data.Origin.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Users (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Users (Directory)", errorDetail(err))
 		return
 	}
 
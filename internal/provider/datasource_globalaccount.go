@@ -205,6 +205,8 @@ __Further documentation:__
 }
 
 func (ds *globalaccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -216,7 +218,7 @@ func (ds *globalaccountDataSource) Read(ctx context.Context, req datasource.Read
 
 	cliRes, _, err := ds.cli.Accounts.GlobalAccount.Get(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Global Account", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Global Account", errorDetail(err))
 		return
 	}
 
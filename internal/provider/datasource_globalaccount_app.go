@@ -147,6 +147,8 @@ func (ds *globalaccountAppDataSource) Schema(_ context.Context, _ datasource.Sch
 }
 
 func (ds *globalaccountAppDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountAppDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -158,7 +160,7 @@ func (ds *globalaccountAppDataSource) Read(ctx context.Context, req datasource.R
 
 	cliRes, _, err := ds.cli.Security.App.GetByGlobalAccount(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource App (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource App (Global Account)", errorDetail(err))
 		return
 	}
 
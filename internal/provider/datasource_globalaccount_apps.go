@@ -143,6 +143,8 @@ func (ds *globalaccountAppsDataSource) Schema(_ context.Context, _ datasource.Sc
 }
 
 func (ds *globalaccountAppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountAppsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -154,7 +156,7 @@ func (ds *globalaccountAppsDataSource) Read(ctx context.Context, req datasource.
 
 	cliRes, _, err := ds.cli.Security.App.ListByGlobalAccount(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Apps (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Apps (Global Account)", errorDetail(err))
 		return
 	}
 
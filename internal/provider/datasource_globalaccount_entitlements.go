@@ -129,6 +129,8 @@ To view all the resources a global account:
 }
 
 func (ds *globalaccountEntitlementsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountEntitlementsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -140,7 +142,7 @@ func (ds *globalaccountEntitlementsDataSource) Read(ctx context.Context, req dat
 
 	cliRes, _, err := ds.cli.Accounts.Entitlement.ListByGlobalAccount(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Entitlements (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Entitlements (Global Account)", errorDetail(err))
 		return
 	}
 
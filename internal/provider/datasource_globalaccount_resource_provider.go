@@ -80,6 +80,8 @@ __Further documentation:__
 }
 
 func (ds *globalaccountResourceProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountResourceProviderType
 
 	diags := req.Config.Get(ctx, &data)
@@ -91,7 +93,7 @@ func (ds *globalaccountResourceProviderDataSource) Read(ctx context.Context, req
 
 	cliRes, _, err := ds.cli.Accounts.ResourceProvider.Get(ctx, data.Provider.ValueString(), data.TechnicalName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Resource Provider (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Resource Provider (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -91,6 +91,8 @@ __Further documentation:__
 }
 
 func (ds *globalaccountResourceProvidersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountResourceProvidersDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -102,7 +104,7 @@ func (ds *globalaccountResourceProvidersDataSource) Read(ctx context.Context, re
 
 	cliRes, _, err := ds.cli.Accounts.ResourceProvider.List(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Resource Providers (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Resource Providers (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -129,6 +129,8 @@ func (ds *globalaccountRoleDataSource) Schema(_ context.Context, _ datasource.Sc
 }
 
 func (ds *globalaccountRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountRoleDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -140,7 +142,7 @@ func (ds *globalaccountRoleDataSource) Read(ctx context.Context, req datasource.
 
 	cliRes, _, err := ds.cli.Security.Role.GetByGlobalAccount(ctx, data.Name.ValueString(), data.RoleTemplateAppId.ValueString(), data.RoleTemplateName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -102,6 +102,8 @@ func (ds *globalaccountRoleCollectionDataSource) Schema(_ context.Context, _ dat
 }
 
 func (ds *globalaccountRoleCollectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountRoleCollectionDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -113,7 +115,7 @@ func (ds *globalaccountRoleCollectionDataSource) Read(ctx context.Context, req d
 
 	cliRes, _, err := ds.cli.Security.RoleCollection.GetByGlobalAccount(ctx, data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role Collection (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role Collection (Global Account)", errorDetail(err))
 		return
 	}
 
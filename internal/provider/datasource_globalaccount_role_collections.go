@@ -109,6 +109,8 @@ func (ds *globalaccountRoleCollectionsDataSource) Schema(_ context.Context, _ da
 }
 
 func (ds *globalaccountRoleCollectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountRoleCollectionsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -120,7 +122,7 @@ func (ds *globalaccountRoleCollectionsDataSource) Read(ctx context.Context, req
 
 	cliRes, _, err := ds.cli.Security.RoleCollection.ListByGlobalAccount(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role Collections (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role Collections (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -128,6 +128,8 @@ __Further documentation:__
 }
 
 func (ds *globalaccountRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountRolesDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -139,7 +141,7 @@ func (ds *globalaccountRolesDataSource) Read(ctx context.Context, req datasource
 
 	cliRes, _, err := ds.cli.Security.Role.ListByGlobalAccount(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -68,6 +68,8 @@ __Further documentation:__
 }
 
 func (ds *globalaccountSecuritySettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountSecuritySettingsType
 
 	diags := req.Config.Get(ctx, &data)
@@ -79,7 +81,7 @@ func (ds *globalaccountSecuritySettingsDataSource) Read(ctx context.Context, req
 
 	cliRes, _, err := ds.cli.Security.Settings.ListByGlobalAccount(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Security Settings (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Security Settings (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -91,6 +91,8 @@ __Further documentation:__
 }
 
 func (ds *globalaccountTrustConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountTrustConfigurationType
 
 	diags := req.Config.Get(ctx, &data)
@@ -102,7 +104,7 @@ func (ds *globalaccountTrustConfigurationDataSource) Read(ctx context.Context, r
 
 	cliRes, _, err := ds.cli.Security.Trust.GetByGlobalAccount(ctx, data.Origin.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Trust Configuration (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Trust Configuration (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -104,6 +104,8 @@ __Further documentation:__
 }
 
 func (ds *globalaccountTrustConfigurationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountTrustConfigurationsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -115,7 +117,7 @@ func (ds *globalaccountTrustConfigurationsDataSource) Read(ctx context.Context,
 
 	cliRes, _, err := ds.cli.Security.Trust.ListByGlobalAccount(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Trust Configurations (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Trust Configurations (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -100,6 +100,8 @@ func (ds *globalaccountUserDataSource) Schema(_ context.Context, _ datasource.Sc
 }
 
 func (ds *globalaccountUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountUserDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -115,7 +117,7 @@ func (ds *globalaccountUserDataSource) Read(ctx context.Context, req datasource.
 
 	cliRes, _, err := ds.cli.Security.User.GetByGlobalAccount(ctx, data.UserName.ValueString(), data.Origin.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource User (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource User (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -71,6 +71,8 @@ __Further documentation:__
 }
 
 func (ds *globalaccountUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data globalaccountUsersDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -86,7 +88,7 @@ func (ds *globalaccountUsersDataSource) Read(ctx context.Context, req datasource
 
 	cliRes, _, err := ds.cli.Security.User.ListByGlobalAccount(ctx, data.Origin.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Users (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Users (Global Account)", errorDetail(err))
 		return
 	}
 
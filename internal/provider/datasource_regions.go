@@ -133,6 +133,8 @@ You must be assigned to the global account admin or viewer role.`,
 }
 
 func (ds *regionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data regionsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -144,7 +146,7 @@ func (ds *regionsDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	cliRes, _, err := ds.cli.Accounts.AvailableRegion.List(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Regions", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Regions", errorDetail(err))
 		return
 	}
 	regions := []regionDataSourceConfig{}
@@ -138,6 +138,8 @@ You must be assigned to the admin or viewer role of the global account, director
 }
 
 func (ds *subaccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountType
 
 	diags := req.Config.Get(ctx, &data)
@@ -149,7 +151,7 @@ func (ds *subaccountDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	cliRes, _, err := ds.cli.Accounts.Subaccount.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Subaccount", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Subaccount", errorDetail(err))
 		return
 	}
 
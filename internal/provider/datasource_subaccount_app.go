@@ -146,6 +146,8 @@ func (ds *subaccountAppDataSource) Schema(_ context.Context, _ datasource.Schema
 }
 
 func (ds *subaccountAppDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountAppDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -157,7 +159,7 @@ func (ds *subaccountAppDataSource) Read(ctx context.Context, req datasource.Read
 
 	cliRes, _, err := ds.cli.Security.App.GetBySubaccount(ctx, data.SubaccountId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource App (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource App (Subaccount)", errorDetail(err))
 		return
 	}
 
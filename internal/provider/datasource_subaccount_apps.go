@@ -159,6 +159,8 @@ func (ds *subaccountAppsDataSource) Schema(_ context.Context, _ datasource.Schem
 }
 
 func (ds *subaccountAppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountAppsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -170,7 +172,7 @@ func (ds *subaccountAppsDataSource) Read(ctx context.Context, req datasource.Rea
 
 	cliRes, _, err := ds.cli.Security.App.ListBySubaccount(ctx, data.SubaccountId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Apps (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Apps (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -113,6 +113,8 @@ To get all entitlements and quota assigned to a specific subaccount:
 }
 
 func (ds *subaccountEntitlementsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountEntitlementsDataSourceConfig
 	var cliRes cis_entitlements.EntitledAndAssignedServicesResponseObject
 	var err error
@@ -136,7 +138,7 @@ func (ds *subaccountEntitlementsDataSource) Read(ctx context.Context, req dataso
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Entitlements (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Entitlements (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -155,6 +155,8 @@ You must be assigned to the subaccount admin or viewer role.`,
 }
 
 func (ds *subaccountEnvironmentInstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountEnvironmentInstanceDataSourceType
 
 	diags := req.Config.Get(ctx, &data)
@@ -166,7 +168,7 @@ func (ds *subaccountEnvironmentInstanceDataSource) Read(ctx context.Context, req
 
 	cliRes, _, err := ds.cli.Accounts.EnvironmentInstance.Get(ctx, data.SubaccountId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Environment Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Environment Instance (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -196,6 +196,8 @@ You must be assigned to the subaccount admin or viewer role.`,
 }
 
 func (ds *subaccountEnvironmentInstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountEnvironmentInstancesDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -207,7 +209,7 @@ func (ds *subaccountEnvironmentInstancesDataSource) Read(ctx context.Context, re
 
 	cliRes, _, err := ds.cli.Accounts.EnvironmentInstance.List(ctx, data.SubaccountId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Environment Instances (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Environment Instances (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -137,6 +137,8 @@ You must be assigned to the subaccount admin or viewer role.`,
 }
 
 func (ds *subaccountEnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountEnvironmentsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -148,7 +150,7 @@ func (ds *subaccountEnvironmentsDataSource) Read(ctx context.Context, req dataso
 
 	cliRes, _, err := ds.cli.Accounts.AvailableEnvironment.List(ctx, data.SubaccountId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Environments (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Environments (Subaccount)", errorDetail(err))
 		return
 	}
 
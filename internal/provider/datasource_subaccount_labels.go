@@ -73,6 +73,8 @@ __Further documentation:__
 }
 
 func (ds *subaccountLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountLabelsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -84,7 +86,7 @@ func (ds *subaccountLabelsDataSource) Read(ctx context.Context, req datasource.R
 
 	cliRes, _, err := ds.cli.Accounts.Label.ListBySubaccount(ctx, data.SubaccountId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Labels (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Labels (Subaccount)", errorDetail(err))
 		return
 	}
 
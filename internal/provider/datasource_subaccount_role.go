@@ -138,6 +138,8 @@ func (ds *subaccountRoleDataSource) Schema(_ context.Context, _ datasource.Schem
 }
 
 func (ds *subaccountRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountRoleDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -149,7 +151,7 @@ func (ds *subaccountRoleDataSource) Read(ctx context.Context, req datasource.Rea
 
 	cliRes, _, err := ds.cli.Security.Role.GetBySubaccount(ctx, data.SubaccountId.ValueString(), data.Name.ValueString(), data.RoleTemplateAppId.ValueString(), data.RoleTemplateName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -112,6 +112,8 @@ func (ds *subaccountRoleCollectionDataSource) Schema(_ context.Context, _ dataso
 }
 
 func (ds *subaccountRoleCollectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountRoleCollectionDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -123,7 +125,7 @@ func (ds *subaccountRoleCollectionDataSource) Read(ctx context.Context, req data
 
 	rolecollection, _, err := ds.cli.Security.RoleCollection.GetBySubaccount(ctx, data.SubaccountId.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role Collection (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role Collection (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -120,6 +120,8 @@ func (ds *subaccountRoleCollectionsDataSource) Schema(_ context.Context, _ datas
 }
 
 func (ds *subaccountRoleCollectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountRoleCollectionsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -131,7 +133,7 @@ func (ds *subaccountRoleCollectionsDataSource) Read(ctx context.Context, req dat
 
 	cliRes, _, err := ds.cli.Security.RoleCollection.ListBySubaccount(ctx, data.SubaccountId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role Collections (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role Collections (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -139,6 +139,8 @@ __Further documentation:__
 }
 
 func (ds *subaccountRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountRolesDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -150,7 +152,7 @@ func (ds *subaccountRolesDataSource) Read(ctx context.Context, req datasource.Re
 
 	cliRes, _, err := ds.cli.Security.Role.ListBySubaccount(ctx, data.SubaccountId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Roles (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Roles (Subaccount)", errorDetail(err))
 		return
 	}
 
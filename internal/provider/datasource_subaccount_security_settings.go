@@ -77,6 +77,8 @@ __Further documentation:__
 }
 
 func (ds *subaccountSecuritySettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountSecuritySettingsType
 
 	diags := req.Config.Get(ctx, &data)
@@ -88,7 +90,7 @@ func (ds *subaccountSecuritySettingsDataSource) Read(ctx context.Context, req da
 
 	cliRes, _, err := ds.cli.Security.Settings.ListBySubaccount(ctx, data.SubaccountId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Security Settings (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Security Settings (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -86,6 +86,36 @@ func (ds *subaccountServiceBindingDataSource) Schema(_ context.Context, _ dataso
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"credentials_map": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The decoded credentials to access the binding. Nested objects and arrays are provided as JSON strings.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"oauth_credentials": schema.SingleNestedAttribute{
+				MarkdownDescription: "The OAuth client of the binding, if its credentials contain one, e.g. for bindings of the SAP Authorization and Trust Management service.",
+				Attributes: map[string]schema.Attribute{
+					"clientid": schema.StringAttribute{
+						MarkdownDescription: "A public identifier of the app.",
+						Computed:            true,
+					},
+					"clientsecret": schema.StringAttribute{
+						MarkdownDescription: "Secret known only to the app and the authorization server.",
+						Computed:            true,
+						Sensitive:           true,
+					},
+					"url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the authorization server to get a token.",
+						Computed:            true,
+					},
+					"xsappname": schema.StringAttribute{
+						MarkdownDescription: "The name of the xsapp used to get the access token.",
+						Computed:            true,
+					},
+				},
+				Computed:  true,
+				Sensitive: true,
+			},
 			"parameters": schema.StringAttribute{
 				MarkdownDescription: "The parameters of the service binding as a valid JSON object.",
 				Computed:            true,
@@ -119,6 +149,8 @@ func (ds *subaccountServiceBindingDataSource) Schema(_ context.Context, _ dataso
 }
 
 func (ds *subaccountServiceBindingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountServiceBindingType
 
 	diags := req.Config.Get(ctx, &data)
@@ -140,7 +172,7 @@ func (ds *subaccountServiceBindingDataSource) Read(ctx context.Context, req data
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Service Binding (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Service Binding (Subaccount)", errorDetail(err))
 		return
 	}
 
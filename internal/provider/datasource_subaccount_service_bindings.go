@@ -26,6 +26,8 @@ type subaccountServiceBindingValue struct {
 	Context           types.String `tfsdk:"context"`
 	BindResource      types.Map    `tfsdk:"bind_resource"`
 	Credentials       types.String `tfsdk:"credentials"`
+	CredentialsMap    types.Map    `tfsdk:"credentials_map"`
+	OAuthCredentials  types.Object `tfsdk:"oauth_credentials"`
 	CreatedDate       types.String `tfsdk:"created_date"`
 	LastModified      types.String `tfsdk:"last_modified"`
 	Labels            types.Map    `tfsdk:"labels"`
@@ -122,6 +124,36 @@ func (ds *subaccountServiceBindingsDataSource) Schema(_ context.Context, _ datas
 							Computed:            true,
 							Sensitive:           true,
 						},
+						"credentials_map": schema.MapAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "The decoded credentials to access the binding. Nested objects and arrays are provided as JSON strings.",
+							Computed:            true,
+							Sensitive:           true,
+						},
+						"oauth_credentials": schema.SingleNestedAttribute{
+							MarkdownDescription: "The OAuth client of the binding, if its credentials contain one, e.g. for bindings of the SAP Authorization and Trust Management service.",
+							Attributes: map[string]schema.Attribute{
+								"clientid": schema.StringAttribute{
+									MarkdownDescription: "A public identifier of the app.",
+									Computed:            true,
+								},
+								"clientsecret": schema.StringAttribute{
+									MarkdownDescription: "Secret known only to the app and the authorization server.",
+									Computed:            true,
+									Sensitive:           true,
+								},
+								"url": schema.StringAttribute{
+									MarkdownDescription: "The URL of the authorization server to get a token.",
+									Computed:            true,
+								},
+								"xsappname": schema.StringAttribute{
+									MarkdownDescription: "The name of the xsapp used to get the access token.",
+									Computed:            true,
+								},
+							},
+							Computed:  true,
+							Sensitive: true,
+						},
 						"created_date": schema.StringAttribute{
 							MarkdownDescription: "The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
 							Computed:            true,
@@ -146,6 +178,8 @@ func (ds *subaccountServiceBindingsDataSource) Schema(_ context.Context, _ datas
 }
 
 func (ds *subaccountServiceBindingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountServiceBindingsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -165,7 +199,7 @@ func (ds *subaccountServiceBindingsDataSource) Read(ctx context.Context, req dat
 
 	cliRes, _, err := ds.cli.Services.Binding.List(ctx, data.SubaccountId.ValueString(), fieldsFilter, labelsFilter)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Service Bindings (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Service Bindings (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -187,6 +221,9 @@ func (ds *subaccountServiceBindingsDataSource) Read(ctx context.Context, req dat
 		bindingValue.BindResource, diags = types.MapValueFrom(ctx, types.StringType, binding.BindResource)
 		resp.Diagnostics.Append(diags...)
 
+		bindingValue.CredentialsMap, bindingValue.OAuthCredentials, diags = serviceBindingCredentialsValueFrom(ctx, binding.Credentials)
+		resp.Diagnostics.Append(diags...)
+
 		bindingValue.Labels, diags = types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, binding.Labels)
 		resp.Diagnostics.Append(diags...)
 
@@ -110,6 +110,8 @@ func (ds *subaccountServiceBrokerDataSource) Schema(_ context.Context, _ datasou
 }
 
 func (ds *subaccountServiceBrokerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountServiceBrokerDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -131,7 +133,7 @@ func (ds *subaccountServiceBrokerDataSource) Read(ctx context.Context, req datas
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Service Broker (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Service Broker (Subaccount)", errorDetail(err))
 		return
 	}
 
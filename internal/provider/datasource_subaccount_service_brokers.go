@@ -126,6 +126,8 @@ func (ds *subaccountServiceBrokersDataSource) Schema(_ context.Context, _ dataso
 }
 
 func (ds *subaccountServiceBrokersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountServiceBrokersDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -145,7 +147,7 @@ func (ds *subaccountServiceBrokersDataSource) Read(ctx context.Context, req data
 
 	cliRes, _, err := ds.cli.Services.Broker.List(ctx, data.SubaccountId.ValueString(), fieldsFilter, labelsFilter)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Service Brokers (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Service Brokers (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -120,6 +120,8 @@ func (ds *subaccountServiceInstanceDataSource) Schema(_ context.Context, _ datas
 }
 
 func (ds *subaccountServiceInstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountServiceInstanceDataSourceType
 
 	diags := req.Config.Get(ctx, &data)
@@ -141,7 +143,7 @@ func (ds *subaccountServiceInstanceDataSource) Read(ctx context.Context, req dat
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Service Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Service Instance (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -142,6 +142,8 @@ func (ds *subaccountServiceInstancesDataSource) Schema(_ context.Context, _ data
 }
 
 func (ds *subaccountServiceInstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountServiceInstancesDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -161,7 +163,7 @@ func (ds *subaccountServiceInstancesDataSource) Read(ctx context.Context, req da
 
 	cliRes, _, err := ds.cli.Services.Instance.List(ctx, data.SubaccountId.ValueString(), fieldsFilter, labelsFilter)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Service Instances (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Service Instances (Subaccount)", errorDetail(err))
 		return
 	}
 
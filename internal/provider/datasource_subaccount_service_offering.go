@@ -143,6 +143,8 @@ func (ds *subaccountServiceOfferingDataSource) Schema(_ context.Context, _ datas
 }
 
 func (ds *subaccountServiceOfferingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountServiceOfferingDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -164,7 +166,7 @@ func (ds *subaccountServiceOfferingDataSource) Read(ctx context.Context, req dat
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Service Offering (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Service Offering (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -167,6 +167,8 @@ func (ds *subaccountServiceOfferingsDataSource) Schema(_ context.Context, _ data
 }
 
 func (ds *subaccountServiceOfferingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountServiceOfferingsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -189,7 +191,7 @@ func (ds *subaccountServiceOfferingsDataSource) Read(ctx context.Context, req da
 
 	cliRes, _, err := ds.cli.Services.Offering.List(ctx, data.SubaccountId.ValueString(), fieldsFilter, labelsFilter, environment)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Service Offerings (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Service Offerings (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -133,6 +133,8 @@ func (ds *subaccountServicePlanDataSource) Schema(_ context.Context, _ datasourc
 }
 
 func (ds *subaccountServicePlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountServicePlanDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -154,7 +156,7 @@ func (ds *subaccountServicePlanDataSource) Read(ctx context.Context, req datasou
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Service Plan (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Service Plan (Subaccount)", errorDetail(err))
 		return
 	}
 
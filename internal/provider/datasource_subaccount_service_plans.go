@@ -154,6 +154,8 @@ func (ds *subaccountServicePlansDataSource) Schema(_ context.Context, _ datasour
 }
 
 func (ds *subaccountServicePlansDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountServicePlansDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -176,7 +178,7 @@ func (ds *subaccountServicePlansDataSource) Read(ctx context.Context, req dataso
 
 	cliRes, _, err := ds.cli.Services.Plan.List(ctx, data.SubaccountId.ValueString(), fieldsFilter, labelsFilter, environment)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Service Plans (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Service Plans (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -111,6 +111,8 @@ func (ds *subaccountServicePlatformDataSource) Schema(_ context.Context, _ datas
 }
 
 func (ds *subaccountServicePlatformDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountServicePlatformDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -132,7 +134,7 @@ func (ds *subaccountServicePlatformDataSource) Read(ctx context.Context, req dat
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Service Platform (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Service Platform (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -127,6 +127,8 @@ func (ds *subaccountServicePlatformsDataSource) Schema(_ context.Context, _ data
 }
 
 func (ds *subaccountServicePlatformsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountServicePlatformsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -146,7 +148,7 @@ func (ds *subaccountServicePlatformsDataSource) Read(ctx context.Context, req da
 
 	cliRes, _, err := ds.cli.Services.Platform.List(ctx, data.SubaccountId.ValueString(), fieldsFilter, labelsFilter)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Service Platforms (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Service Platforms (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -167,6 +167,8 @@ You must be assigned to the subaccount admin or viewer role.`,
 }
 
 func (ds *subaccountSubscriptionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountSubscriptionType
 
 	diags := req.Config.Get(ctx, &data)
@@ -178,7 +180,7 @@ func (ds *subaccountSubscriptionDataSource) Read(ctx context.Context, req dataso
 
 	cliRes, _, err := ds.cli.Accounts.Subscription.Get(ctx, data.SubaccountId.ValueString(), data.AppName.ValueString(), data.PlanName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Subscription (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Subscription (Subaccount)", errorDetail(err))
 		return
 	}
 
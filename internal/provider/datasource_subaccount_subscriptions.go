@@ -233,6 +233,8 @@ You must be assigned to the subaccount admin or viewer role.`,
 }
 
 func (ds *subaccountSubscriptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountSubscriptionsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -244,7 +246,7 @@ func (ds *subaccountSubscriptionsDataSource) Read(ctx context.Context, req datas
 
 	cliRes, _, err := ds.cli.Accounts.Subscription.List(ctx, data.SubaccountId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Subscriptions (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Subscriptions (Subaccount)", errorDetail(err))
 		return
 	}
 
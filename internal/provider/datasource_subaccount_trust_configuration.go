@@ -110,6 +110,8 @@ __Further documentation:__
 }
 
 func (ds *subaccountTrustConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountTrustConfigurationType
 
 	diags := req.Config.Get(ctx, &data)
@@ -121,7 +123,7 @@ func (ds *subaccountTrustConfigurationDataSource) Read(ctx context.Context, req
 
 	cliRes, _, err := ds.cli.Security.Trust.GetBySubaccount(ctx, data.SubaccountId.ValueString(), data.Origin.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Trust Configuration (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Trust Configuration (Subaccount)", errorDetail(err))
 		return
 	}
 
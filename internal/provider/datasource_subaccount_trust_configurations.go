@@ -126,6 +126,8 @@ __Further documentation:__
 }
 
 func (ds *subaccountTrustConfigurationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountTrustConfigurationsDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -137,7 +139,7 @@ func (ds *subaccountTrustConfigurationsDataSource) Read(ctx context.Context, req
 
 	cliRes, _, err := ds.cli.Security.Trust.ListBySubaccount(ctx, data.SubaccountId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Trust Configurations (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Trust Configurations (Subaccount)", errorDetail(err))
 		return
 	}
 
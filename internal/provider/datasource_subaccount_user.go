@@ -109,6 +109,8 @@ func (ds *subaccountUserDataSource) Schema(_ context.Context, _ datasource.Schem
 }
 
 func (ds *subaccountUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountUserDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -124,7 +126,7 @@ func (ds *subaccountUserDataSource) Read(ctx context.Context, req datasource.Rea
 
 	cliRes, _, err := ds.cli.Security.User.GetBySubaccount(ctx, data.SubaccountId.ValueString(), data.UserName.ValueString(), data.Origin.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource User (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource User (Subaccount)", errorDetail(err))
 		return
 	}
 
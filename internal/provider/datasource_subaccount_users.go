@@ -79,6 +79,8 @@ __Further documentation:__
 }
 
 func (ds *subaccountUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountUsersDataSourceConfig
 
 	diags := req.Config.Get(ctx, &data)
@@ -94,7 +96,7 @@ func (ds *subaccountUsersDataSource) Read(ctx context.Context, req datasource.Re
 
 	cliRes, _, err := ds.cli.Security.User.ListBySubaccount(ctx, data.SubaccountId.ValueString(), data.Origin.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Users (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Users (Subaccount)", errorDetail(err))
 		return
 	}
 
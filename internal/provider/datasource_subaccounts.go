@@ -188,6 +188,8 @@ You must be assigned to the admin or viewer role of the global account, director
 }
 
 func (ds *subaccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendServerWarnings(ds.cli, &resp.Diagnostics)
+
 	var data subaccountsType
 
 	diags := req.Config.Get(ctx, &data)
@@ -204,7 +206,7 @@ func (ds *subaccountsDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	cliRes, _, err := ds.cli.Accounts.Subaccount.List(ctx, labelsFilter)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Subaccounts", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Subaccounts", errorDetail(err))
 		return
 	}
 
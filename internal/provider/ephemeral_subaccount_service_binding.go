@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
+	"github.com/SAP/terraform-provider-btp/internal/validation/jsonvalidator"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+// privateKeyCreatedBinding is the key of the private data which identifies a binding created by the ephemeral resource
+const privateKeyCreatedBinding = "created_binding"
+
+type subaccountServiceBindingEphemeralPrivateData struct {
+	SubaccountId string `json:"subaccount_id"`
+	Id           string `json:"id"`
+}
+
+func newSubaccountServiceBindingEphemeralResource() ephemeral.EphemeralResource {
+	return &subaccountServiceBindingEphemeralResource{}
+}
+
+type subaccountServiceBindingEphemeralResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (er *subaccountServiceBindingEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_subaccount_service_binding", req.ProviderTypeName)
+}
+
+func (er *subaccountServiceBindingEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	er.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (er *subaccountServiceBindingEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Provides the access details of a service binding without persisting them in the Terraform state or plan.
+
+If ` + "`service_instance_id`" + ` is set, a new service binding is created for the run and deleted again once Terraform does not need it anymore. Otherwise the existing service binding with the given ` + "`id`" + ` or ` + "`name`" + ` is looked up.
+
+__Tip:__
+Ephemeral resources require Terraform 1.10 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"service_instance_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service instance for which a service binding is created. If not set, an existing service binding is looked up.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("id")),
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service binding to look up.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service binding to create or to look up.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"parameters": schema.StringAttribute{
+				MarkdownDescription: "The parameters of the service binding to create as a valid JSON object.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("service_instance_id")),
+					jsonvalidator.ValidJSON(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.SetType{
+					ElemType: types.StringType,
+				},
+				MarkdownDescription: "The set of words or phrases assigned to the service binding.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ready": schema.BoolAttribute{
+				MarkdownDescription: "Shows whether the service binding is ready.",
+				Computed:            true,
+			},
+			"context": schema.StringAttribute{
+				MarkdownDescription: "Contextual data for the resource.",
+				Computed:            true,
+			},
+			"bind_resource": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Contains the resources associated with the binding.",
+				Computed:            true,
+			},
+			"credentials": schema.StringAttribute{
+				MarkdownDescription: "The credentials to access the binding.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"credentials_map": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The decoded credentials to access the binding. Nested objects and arrays are provided as JSON strings.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"oauth_credentials": schema.SingleNestedAttribute{
+				MarkdownDescription: "The OAuth client of the binding, if its credentials contain one, e.g. for bindings of the SAP Authorization and Trust Management service.",
+				Attributes: map[string]schema.Attribute{
+					"clientid": schema.StringAttribute{
+						MarkdownDescription: "A public identifier of the app.",
+						Computed:            true,
+					},
+					"clientsecret": schema.StringAttribute{
+						MarkdownDescription: "Secret known only to the app and the authorization server.",
+						Computed:            true,
+						Sensitive:           true,
+					},
+					"url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the authorization server to get a token.",
+						Computed:            true,
+					},
+					"xsappname": schema.StringAttribute{
+						MarkdownDescription: "The name of the xsapp used to get the access token.",
+						Computed:            true,
+					},
+				},
+				Computed:  true,
+				Sensitive: true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The current state of the service binding. Possible values are: " +
+					getFormattedValueAsTableRow("state", "description") +
+					getFormattedValueAsTableRow("---", "---") +
+					getFormattedValueAsTableRow("`in progress`", "The operation or processing is in progress") +
+					getFormattedValueAsTableRow("`failed`", "The operation or processing failed") +
+					getFormattedValueAsTableRow("`succeeded`", "The operation or processing succeeded"),
+				Computed: true,
+			},
+			"created_date": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was last modified in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (er *subaccountServiceBindingEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config subaccountServiceBindingType
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cliRes servicemanager.ServiceBindingResponseObject
+	var err error
+
+	if !config.ServiceInstanceId.IsNull() {
+		cliRes, err = er.createBinding(ctx, config)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Opening Ephemeral Resource Service Binding (Subaccount)", errorDetail(err))
+			return
+		}
+
+		// The binding only lives as long as Terraform needs it, so it is deleted by Close
+		privateData, err := json.Marshal(subaccountServiceBindingEphemeralPrivateData{
+			SubaccountId: config.SubaccountId.ValueString(),
+			Id:           cliRes.Id,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Opening Ephemeral Resource Service Binding (Subaccount)", errorDetail(err))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyCreatedBinding, privateData)...)
+	} else if !config.Id.IsNull() {
+		cliRes, _, err = er.cli.Services.Binding.GetById(ctx, config.SubaccountId.ValueString(), config.Id.ValueString())
+	} else {
+		cliRes, _, err = er.cli.Services.Binding.GetByName(ctx, config.SubaccountId.ValueString(), config.Name.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Opening Ephemeral Resource Service Binding (Subaccount)", errorDetail(err))
+		return
+	}
+
+	result, diags := subaccountServiceBindingValueFrom(ctx, cliRes)
+	result.Parameters = config.Parameters // the API doesn't return parameters for already created bindings
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Result.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (er *subaccountServiceBindingEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateData, diags := req.Private.GetKey(ctx, privateKeyCreatedBinding)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateData == nil {
+		// Bindings which have only been looked up are kept
+		return
+	}
+
+	var binding subaccountServiceBindingEphemeralPrivateData
+	if err := json.Unmarshal(privateData, &binding); err != nil {
+		resp.Diagnostics.AddError("API Error Closing Ephemeral Resource Service Binding (Subaccount)", errorDetail(err))
+		return
+	}
+
+	if err := deleteServiceBinding(ctx, er.cli, binding.SubaccountId, binding.Id); err != nil {
+		resp.Diagnostics.AddError("API Error Closing Ephemeral Resource Service Binding (Subaccount)", errorDetail(err))
+		return
+	}
+}
+
+// createBinding creates the configured binding. A binding which does not get ready is deleted right away, as Close is only called for successfully opened ephemeral resources.
+func (er *subaccountServiceBindingEphemeralResource) createBinding(ctx context.Context, config subaccountServiceBindingType) (servicemanager.ServiceBindingResponseObject, error) {
+	cliReq := btpcli.SubaccountServiceBindingCreateInput{
+		Subaccount:        config.SubaccountId.ValueString(),
+		ServiceInstanceId: config.ServiceInstanceId.ValueString(),
+		Name:              config.Name.ValueString(),
+		Parameters:        config.Parameters.ValueString(),
+	}
+
+	if !config.Labels.IsNull() && !config.Labels.IsUnknown() {
+		var labels map[string][]string
+		config.Labels.ElementsAs(ctx, &labels, false)
+
+		cliReq.Labels = labels
+	}
+
+	cliRes, err := createServiceBinding(ctx, er.cli, cliReq)
+	if err != nil && len(cliRes.Id) > 0 {
+		_ = deleteServiceBinding(ctx, er.cli, cliReq.Subaccount, cliRes.Id)
+	}
+
+	return cliRes, err
+}
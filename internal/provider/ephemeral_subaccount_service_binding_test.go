@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestEphemeralSubaccountServiceBinding(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - service binding is created and deleted again", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/ephemeral_subaccount_service_binding.create")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProvidersWithEcho(getProviders(rec.GetDefaultClient())),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclEphemeralSubaccountServiceBindingByServiceInstance("uut", "integration-test-services-static", "tf-testacc-alertnotification-instance", "tfint-test-ephemeral-sb"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("echo.uut", "data.id", regexpValidUUID),
+						resource.TestMatchResourceAttr("echo.uut", "data.subaccount_id", regexpValidUUID),
+						resource.TestMatchResourceAttr("echo.uut", "data.service_instance_id", regexpValidUUID),
+						resource.TestCheckResourceAttr("echo.uut", "data.name", "tfint-test-ephemeral-sb"),
+						resource.TestCheckResourceAttr("echo.uut", "data.ready", "true"),
+						resource.TestCheckResourceAttrSet("echo.uut", "data.credentials"),
+						resource.TestMatchResourceAttr("echo.uut", "data.created_date", regexpValidRFC3999Format),
+					),
+				},
+				{
+					// the binding which has been created by the ephemeral resource is deleted once it is closed
+					Config: hclProviderFor(user) + hclDatasourceSubaccountServiceBindings("all", "integration-test-services-static"),
+					Check:  checkNoServiceBindingNamed("data.btp_subaccount_service_bindings.all", "tfint-test-ephemeral-sb"),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - service binding by id", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/ephemeral_subaccount_service_binding.by_id")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProvidersWithEcho(getProviders(rec.GetDefaultClient())),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclEphemeralSubaccountServiceBindingById("uut", "integration-test-services-static", "test-service-binding"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("echo.uut", "data.id", regexpValidUUID),
+						resource.TestCheckResourceAttr("echo.uut", "data.name", "test-service-binding"),
+						resource.TestCheckResourceAttr("echo.uut", "data.ready", "true"),
+						resource.TestCheckResourceAttrSet("echo.uut", "data.credentials"),
+					),
+				},
+				{
+					// the binding which has only been looked up is kept, when the ephemeral resource is closed
+					Config: hclProviderFor(user) + hclDatasourceSubaccountServiceBindingBySubaccountNameByBindingName("uut", "integration-test-services-static", "test-service-binding"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.btp_subaccount_service_binding.uut", "name", "test-service-binding"),
+						resource.TestCheckResourceAttr("data.btp_subaccount_service_binding.uut", "ready", "true"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - service binding by name", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/ephemeral_subaccount_service_binding.by_name")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProvidersWithEcho(getProviders(rec.GetDefaultClient())),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclEphemeralSubaccountServiceBindingByName("uut", "integration-test-services-static", "test-service-binding-two"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("echo.uut", "data.id", regexpValidUUID),
+						resource.TestCheckResourceAttr("echo.uut", "data.name", "test-service-binding-two"),
+						resource.TestCheckResourceAttr("echo.uut", "data.ready", "true"),
+						resource.TestCheckResourceAttrSet("echo.uut", "data.credentials"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - service instance and id are conflicting", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProvidersWithEcho(getProviders(nil)),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+ephemeral "btp_subaccount_service_binding" "uut" {
+	subaccount_id       = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	service_instance_id = "df532d07-57a7-415e-a261-23a398ef068a"
+	id                  = "b02e4b22-906b-40c5-9c5e-dbb6a9068444"
+}`,
+					ExpectError: regexp.MustCompile(`Attribute "id" cannot be specified when "service_instance_id" is specified`),
+				},
+			},
+		})
+	})
+}
+
+// getProvidersWithEcho adds the echo provider, which persists the data of ephemeral resources in its state, so that it can be checked
+func getProvidersWithEcho(providers map[string]func() (tfprotov6.ProviderServer, error)) map[string]func() (tfprotov6.ProviderServer, error) {
+	providers["echo"] = echoprovider.NewProviderServer()
+	return providers
+}
+
+func checkNoServiceBindingNamed(resourceName string, bindingName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		nameKey := regexp.MustCompile(`^values\.\d+\.name$`)
+
+		for key, value := range rs.Primary.Attributes {
+			if nameKey.MatchString(key) && value == bindingName {
+				return fmt.Errorf("the service binding '%s' still exists", bindingName)
+			}
+		}
+
+		return nil
+	}
+}
+
+func hclEphemeralSubaccountServiceBindingByServiceInstance(resourceName string, subaccountName string, serviceInstanceName string, name string) string {
+	return fmt.Sprintf(`
+		data "btp_subaccounts" "all" {}
+		data "btp_subaccount_service_instances" "all" {
+			subaccount_id = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+		}
+		ephemeral "btp_subaccount_service_binding" "%[1]s" {
+			subaccount_id       = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+			service_instance_id = [for ssi in data.btp_subaccount_service_instances.all.values : ssi.id if ssi.name == "%[3]s"][0]
+			name                = "%[4]s"
+		}
+		provider "echo" {
+			data = ephemeral.btp_subaccount_service_binding.%[1]s
+		}
+		resource "echo" "%[1]s" {}`, resourceName, subaccountName, serviceInstanceName, name)
+}
+
+func hclEphemeralSubaccountServiceBindingById(resourceName string, subaccountName string, bindingName string) string {
+	return fmt.Sprintf(`
+		data "btp_subaccounts" "all" {}
+		data "btp_subaccount_service_bindings" "all" {
+			subaccount_id = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+		}
+		ephemeral "btp_subaccount_service_binding" "%[1]s" {
+			subaccount_id = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+			id            = [for sb in data.btp_subaccount_service_bindings.all.values : sb.id if sb.name == "%[3]s"][0]
+		}
+		provider "echo" {
+			data = ephemeral.btp_subaccount_service_binding.%[1]s
+		}
+		resource "echo" "%[1]s" {}`, resourceName, subaccountName, bindingName)
+}
+
+func hclEphemeralSubaccountServiceBindingByName(resourceName string, subaccountName string, bindingName string) string {
+	return fmt.Sprintf(`
+		data "btp_subaccounts" "all" {}
+		ephemeral "btp_subaccount_service_binding" "%[1]s" {
+			subaccount_id = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+			name          = "%[3]s"
+		}
+		provider "echo" {
+			data = ephemeral.btp_subaccount_service_binding.%[1]s
+		}
+		resource "echo" "%[1]s" {}`, resourceName, subaccountName, bindingName)
+}
@@ -2,14 +2,22 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const (
+	subaccountAdminRoleCollection = "Subaccount Administrator"
+	directoryAdminRoleCollection  = "Directory Administrator"
+)
+
 func stringNullIfEmpty(val string) types.String {
 	if len(val) == 0 {
 		return types.StringNull()
@@ -30,7 +38,110 @@ func handleReadErrors(ctx context.Context, rawRes btpcli.CommandResponse, resp *
 	if rawRes.StatusCode == 404 {
 		resp.State.RemoveResource(ctx)
 	} else {
-		resp.Diagnostics.AddError(fmt.Sprintf("API Error Reading %s", resLogName), fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError(fmt.Sprintf("API Error Reading %s", resLogName), errorDetail(err))
+	}
+
+}
+
+// appendServerWarnings reports the announcements of the BTP CLI server, e.g. deprecations or pending protocol changes, as warnings.
+// It is deferred by every operation, so that the announcements received by its commands are reported right away.
+func appendServerWarnings(client *btpcli.ClientFacade, diags *diag.Diagnostics) {
+	if client == nil {
+		return
+	}
+
+	for _, warning := range client.TakeServerWarnings() {
+		diags.AddWarning("BTP CLI Server Announcement", warning)
+	}
+}
+
+// lockSubaccount holds the subaccount for the remaining operation of a resource, so that all of its requests are serialized
+// with the changes of other resources in the same subaccount. The returned function must be called to release the subaccount.
+func lockSubaccount(ctx context.Context, client *btpcli.ClientFacade, subaccountId string, diags *diag.Diagnostics) (context.Context, func()) {
+	lockedCtx, unlock, err := client.LockSubaccount(ctx, subaccountId)
+	if err != nil {
+		diags.AddError("Client Error Waiting For Subaccount", errorDetail(err))
+		return ctx, func() {}
+	}
+
+	return lockedCtx, unlock
+}
+
+// errorDetail renders an error for the diagnostics. Failures reported by the backend are amended with the correlation ID, so
+// that the request can be traced by the support.
+func errorDetail(err error) string {
+	var backendErr *btpcli.BackendError
+
+	if errors.As(err, &backendErr) && backendErr.BackendStatusCode > 0 && len(backendErr.CorrelationID) > 0 {
+		return fmt.Sprintf("%s\n\nCorrelation ID: %s", err, backendErr.CorrelationID)
+	}
+
+	return fmt.Sprintf("%s", err)
+}
+
+// diffAdmins determines the admins which must be assigned and unassigned to get from the admins in the state to the planned ones
+func diffAdmins(ctx context.Context, stateAdmins types.Set, planAdmins types.Set) (toBeAssigned []string, toBeUnassigned []string) {
+	var current, planned []string
+	stateAdmins.ElementsAs(ctx, &current, false)
+	planAdmins.ElementsAs(ctx, &planned, false)
+
+	for _, admin := range planned {
+		if !slices.Contains(current, admin) {
+			toBeAssigned = append(toBeAssigned, admin)
+		}
+	}
+
+	for _, admin := range current {
+		if !slices.Contains(planned, admin) {
+			toBeUnassigned = append(toBeUnassigned, admin)
+		}
+	}
+
+	return
+}
+
+// labelsToApply determines the complete set of labels of an entity after the planned labels are applied. An authoritative
+// configuration replaces all labels of the entity, otherwise only the previously and the currently managed keys are changed.
+func labelsToApply(current map[string][]string, managedKeys []string, planned map[string][]string, authoritative bool) map[string][]string {
+	result := map[string][]string{}
+
+	if !authoritative {
+		for key, values := range current {
+			if !slices.Contains(managedKeys, key) {
+				result[key] = values
+			}
+		}
+	}
+
+	for key, values := range planned {
+		result[key] = values
+	}
+
+	return result
+}
+
+// labelKeys returns the keys of the labels in the state or plan
+func labelKeys(labels types.Map) []string {
+	keys := []string{}
+	for key := range labels.Elements() {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// managedLabels filters the current labels of an entity by the keys which are managed, unless the configuration is authoritative
+func managedLabels(current map[string][]string, managedKeys []string, authoritative bool) map[string][]string {
+	if authoritative {
+		return current
+	}
+
+	result := map[string][]string{}
+	for _, key := range managedKeys {
+		if values, ok := current[key]; ok {
+			result[key] = values
+		}
 	}
 
+	return result
 }
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+)
+
+func TestErrorDetail(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		expects     string
+	}{
+		{
+			description: "plain error",
+			err:         errors.New("something went wrong"),
+			expects:     "something went wrong",
+		},
+		{
+			description: "error reported by the CLI server already contains the correlation ID",
+			err:         &btpcli.BackendError{StatusCode: 504, CorrelationID: "correlation-id", Message: "Command timed out."},
+			expects:     "Command timed out. [Status: 504; Correlation ID: correlation-id]",
+		},
+		{
+			description: "error reported by the backend is amended by the correlation ID",
+			err:         &btpcli.BackendError{StatusCode: 200, BackendStatusCode: 409, CorrelationID: "correlation-id", Message: "conflict"},
+			expects:     "conflict\n\nCorrelation ID: correlation-id",
+		},
+		{
+			description: "wrapped error reported by the backend",
+			err:         fmt.Errorf("wrapped: %w", &btpcli.BackendError{StatusCode: 200, BackendStatusCode: 409, CorrelationID: "correlation-id", Message: "conflict"}),
+			expects:     "wrapped: conflict\n\nCorrelation ID: correlation-id",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			assert.Equal(t, test.expects, errorDetail(test.err))
+		})
+	}
+}
+
+func TestIsRetriableError(t *testing.T) {
+	assert.False(t, isRetriableError(nil))
+	assert.False(t, isRetriableError(errors.New("[Error: 30004/400]")))
+	assert.False(t, isRetriableError(&btpcli.BackendError{BackendStatusCode: 400, Message: "[Error: 11006/400]"}))
+	assert.True(t, isRetriableError(&btpcli.BackendError{BackendStatusCode: 400, Message: "the entity is locked [Error: 30004/400]"}))
+}
+
+func TestDiffAdmins(t *testing.T) {
+	toSet := func(admins ...string) types.Set {
+		elements := []attr.Value{}
+		for _, admin := range admins {
+			elements = append(elements, types.StringValue(admin))
+		}
+		return types.SetValueMust(types.StringType, elements)
+	}
+
+	t.Run("admins added and removed", func(t *testing.T) {
+		toBeAssigned, toBeUnassigned := diffAdmins(context.TODO(), toSet("jane.doe@test.com", "john.doe@test.com"), toSet("john.doe@test.com", "max.mustermann@test.com"))
+
+		assert.Equal(t, []string{"max.mustermann@test.com"}, toBeAssigned)
+		assert.Equal(t, []string{"jane.doe@test.com"}, toBeUnassigned)
+	})
+	t.Run("no admins in state", func(t *testing.T) {
+		toBeAssigned, toBeUnassigned := diffAdmins(context.TODO(), types.SetNull(types.StringType), toSet("jane.doe@test.com"))
+
+		assert.Equal(t, []string{"jane.doe@test.com"}, toBeAssigned)
+		assert.Empty(t, toBeUnassigned)
+	})
+}
+
+func TestLabelsToApply(t *testing.T) {
+	current := map[string][]string{
+		"owner":       {"platform-team"},
+		"cost-center": {"4711"},
+		"app":         {"sales"},
+	}
+
+	t.Run("additive keeps unmanaged keys", func(t *testing.T) {
+		assert.Equal(t, map[string][]string{
+			"owner":       {"app-team"},
+			"cost-center": {"0815"},
+			"app":         {"sales"},
+		}, labelsToApply(current, []string{"cost-center"}, map[string][]string{"owner": {"app-team"}, "cost-center": {"0815"}}, false))
+	})
+	t.Run("additive removes keys which are no longer managed", func(t *testing.T) {
+		assert.Equal(t, map[string][]string{
+			"owner": {"platform-team"},
+			"app":   {"sales"},
+		}, labelsToApply(current, []string{"cost-center"}, map[string][]string{}, false))
+	})
+	t.Run("authoritative replaces all keys", func(t *testing.T) {
+		assert.Equal(t, map[string][]string{
+			"owner": {"app-team"},
+		}, labelsToApply(current, []string{"cost-center"}, map[string][]string{"owner": {"app-team"}}, true))
+	})
+}
+
+func TestManagedLabels(t *testing.T) {
+	current := map[string][]string{
+		"owner":       {"platform-team"},
+		"cost-center": {"4711"},
+	}
+
+	assert.Equal(t, map[string][]string{"owner": {"platform-team"}}, managedLabels(current, []string{"owner", "department"}, false))
+	assert.Equal(t, current, managedLabels(current, []string{"owner"}, true))
+}
+
+// newLoggedInClientFacade returns a client facade, which is logged in at a CLI server that serves the commands with the given handler
+func newLoggedInClientFacade(t *testing.T, commandHandler http.HandlerFunc) *btpcli.ClientFacade {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/login/") {
+			w.Header().Set(btpcli.HeaderCLISessionId, "session-id")
+			fmt.Fprintf(w, "{}")
+			return
+		}
+
+		w.Header().Set(btpcli.HeaderCLIBackendStatus, "200")
+		commandHandler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	srvUrl, _ := url.Parse(srv.URL)
+	client := btpcli.NewClientFacade(btpcli.NewV2ClientWithHttpClient(srv.Client(), srvUrl))
+
+	_, err := client.Login(context.TODO(), btpcli.NewLoginRequest("my-globalaccount", "john.doe@int.test", "secret"))
+	assert.NoError(t, err)
+
+	return client
+}
+
+// newTestState returns a state of the given resource schema with the given attributes set
+func newTestState(t *testing.T, res resource.Resource, attributes map[string]attr.Value) tfsdk.State {
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(context.TODO(), resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.TODO()), nil)}
+	for name, value := range attributes {
+		diags := state.SetAttribute(context.TODO(), path.Root(name), value)
+		assert.False(t, diags.HasError(), "%v", diags)
+	}
+
+	return state
+}
+
+func TestAppendServerWarnings(t *testing.T) {
+	t.Run("happy path - announcements of resource operations are reported", func(t *testing.T) {
+		client := newLoggedInClientFacade(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(btpcli.HeaderCLIServerMessage, "Command 'delete security/role-collection' is deprecated.")
+			fmt.Fprintf(w, "{}")
+		})
+
+		uut := &subaccountRoleCollectionResource{cli: client}
+		req := resource.DeleteRequest{State: newTestState(t, uut, map[string]attr.Value{
+			"subaccount_id": types.StringValue("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+			"name":          types.StringValue("My Role Collection"),
+		})}
+		resp := &resource.DeleteResponse{}
+
+		uut.Delete(context.TODO(), req, resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		if assert.Equal(t, 1, resp.Diagnostics.WarningsCount()) {
+			assert.Equal(t, "BTP CLI Server Announcement", resp.Diagnostics.Warnings()[0].Summary())
+			assert.Equal(t, "Command 'delete security/role-collection' is deprecated.", resp.Diagnostics.Warnings()[0].Detail())
+		}
+	})
+	t.Run("happy path - no client", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		appendServerWarnings(nil, &diags)
+
+		assert.Empty(t, diags)
+	})
+}
@@ -2,16 +2,22 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,9 +30,15 @@ import (
 const userPasswordFlow = "userPasswordFlow"
 const x509Flow = "x509Flow"
 const idTokenFlow = "idTokenFlow"
+const idTokenFileFlow = "idTokenFileFlow"
 const errorMessagePostfixWithEnv = "If either is already set, ensure the value is not empty."
 const errorMessagePostfixWithoutEnv = "If it is already set, ensure the value is not empty."
 
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
 func New() provider.Provider {
 	return NewWithClient(http.DefaultClient)
 }
@@ -73,6 +85,14 @@ func (p *btpcliProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"idtoken_file": schema.StringAttribute{
+				MarkdownDescription: "The path to a file containing a valid id token, e.g. an OIDC token issued to a CI pipeline. To be provided instead of 'username' and 'password'. The file is read again whenever the session expires, so that rotated tokens are picked up. This can also be sourced from the `BTP_IDTOKEN_FILE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("username"), path.MatchRoot("password"), path.MatchRoot("idp"), path.MatchRoot("idtoken"), path.MatchRoot("tls_idp_url"), path.MatchRoot("tls_client_key"), path.MatchRoot("tls_client_certificate")),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"idp": schema.StringAttribute{
 				MarkdownDescription: "The identity provider to be used for authentication (only required for custom idp).",
 				Optional:            true,
@@ -81,40 +101,120 @@ func (p *btpcliProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				MarkdownDescription: "The URL of the identity provider to be used for authentication (only required for x509 auth).",
 				Optional:            true,
 				Validators: []validator.String{
-					stringvalidator.ConflictsWith(path.MatchRoot("password"), path.MatchRoot("idtoken")),
+					stringvalidator.ConflictsWith(path.MatchRoot("password"), path.MatchRoot("idtoken"), path.MatchRoot("idtoken_file")),
 					stringvalidator.AlsoRequires(path.MatchRoot("tls_client_key"), path.MatchRoot("tls_client_certificate")),
 				},
 			},
 			"tls_client_key": schema.StringAttribute{
-				MarkdownDescription: "PEM encoded private key (only required for x509 auth).",
+				MarkdownDescription: "PEM encoded private key (only required for x509 auth). It is only presented to the identity provider, as the BTP CLI server does not authenticate by client certificates.",
 				Optional:            true,
 				Validators: []validator.String{
-					stringvalidator.ConflictsWith(path.MatchRoot("password"), path.MatchRoot("idtoken")),
+					stringvalidator.ConflictsWith(path.MatchRoot("password"), path.MatchRoot("idtoken"), path.MatchRoot("idtoken_file")),
 					stringvalidator.AlsoRequires(path.MatchRoot("tls_idp_url"), path.MatchRoot("tls_client_certificate")),
 				},
 			},
 			"tls_client_certificate": schema.StringAttribute{
-				MarkdownDescription: "PEM encoded certificate (only required for x509 auth).",
+				MarkdownDescription: "PEM encoded certificate (only required for x509 auth). It is only presented to the identity provider, as the BTP CLI server does not authenticate by client certificates.",
 				Optional:            true,
 				Validators: []validator.String{
-					stringvalidator.ConflictsWith(path.MatchRoot("password"), path.MatchRoot("idtoken")),
+					stringvalidator.ConflictsWith(path.MatchRoot("password"), path.MatchRoot("idtoken"), path.MatchRoot("idtoken_file")),
 					stringvalidator.AlsoRequires(path.MatchRoot("tls_idp_url"), path.MatchRoot("tls_client_key")),
 				},
 			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the HTTP proxy used to connect to the BTP CLI server and the identity provider (e.g. `http://proxy.example.com:3128`). If not set, the proxy is determined by the `HTTPS_PROXY` and `NO_PROXY` environment variables. This can also be sourced from the `BTP_PROXY_URL` environment variable.",
+				Optional:            true,
+			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates which are trusted in addition to the system's CA certificates when connecting to the BTP CLI server and the identity provider. This can also be sourced from the `BTP_CA_CERTIFICATE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_certificate_file")),
+				},
+			},
+			"ca_certificate_file": schema.StringAttribute{
+				MarkdownDescription: "The path to a file containing PEM encoded CA certificates which are trusted in addition to the system's CA certificates when connecting to the BTP CLI server and the identity provider. This can also be sourced from the `BTP_CA_CERTIFICATE_FILE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_certificate")),
+				},
+			},
+			"tls_min_version": schema.StringAttribute{
+				MarkdownDescription: "The minimum TLS version used to connect to the BTP CLI server and the identity provider. Possible values are `1.2` (default) and `1.3`. This can also be sourced from the `BTP_TLS_MIN_VERSION` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("1.2", "1.3"),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of retries of a read request in case the BTP CLI server is temporarily unavailable or rate limits the requests (HTTP 429, 502, 503, 504). The retries use an exponential backoff and honor the `Retry-After` header. Set to `0` to disable retries. Defaults to `%d`.", btpcli.DefaultRetryPolicy.MaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of requests sent to the BTP CLI server at the same time. Use it to avoid rate limiting in case of a high Terraform parallelism. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"read_cache_ttl": schema.Int64Attribute{
+				MarkdownDescription: "The time in seconds for which the results of read requests are reused within a Terraform run, e.g. the global account hierarchy which is read for every subaccount. Any change of a subaccount, directory, or the global account invalidates the cached results which may be affected by it. Disabled by default.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The maximum number of requests sent to the BTP CLI server per second. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.1),
+				},
+			},
+			"serialize_subaccount_requests": schema.BoolAttribute{
+				MarkdownDescription: "Set to `true` to execute at most one change per subaccount at a time, as concurrent changes of e.g. entitlements or role collections in the same subaccount conflict with each other. A change includes all requests of the resource operation, e.g. the creation of an entitlement and the polling until it has been assigned. Defaults to `false`.",
+				Optional:            true,
+			},
+			"session_cache": schema.BoolAttribute{
+				MarkdownDescription: "Set to `true` to cache the sessions on disk, so that they are shared across provider instances and Terraform runs instead of logging in every time. Only the logins with `username` are cached. The cache files contain session ids and are only accessible by the owner. This can also be sourced from the `BTP_SESSION_CACHE` environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"session_cache_dir": schema.StringAttribute{
+				MarkdownDescription: "The directory in which the sessions are cached, if `session_cache` is enabled. Defaults to `terraform-provider-btp/sessions` in the user's cache directory. This can also be sourced from the `BTP_SESSION_CACHE_DIR` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 		},
 	}
 }
 
 type providerData struct {
-	CLIServerURL         types.String `tfsdk:"cli_server_url"`
-	GlobalAccount        types.String `tfsdk:"globalaccount"`
-	Username             types.String `tfsdk:"username"`
-	Password             types.String `tfsdk:"password"`
-	IdToken              types.String `tfsdk:"idtoken"`
-	IdentityProvider     types.String `tfsdk:"idp"`
-	IdentityProviderURL  types.String `tfsdk:"tls_idp_url"`
-	TLSClientKey         types.String `tfsdk:"tls_client_key"`
-	TLSClientCertificate types.String `tfsdk:"tls_client_certificate"`
+	CLIServerURL                types.String  `tfsdk:"cli_server_url"`
+	GlobalAccount               types.String  `tfsdk:"globalaccount"`
+	Username                    types.String  `tfsdk:"username"`
+	Password                    types.String  `tfsdk:"password"`
+	IdToken                     types.String  `tfsdk:"idtoken"`
+	IdTokenFile                 types.String  `tfsdk:"idtoken_file"`
+	IdentityProvider            types.String  `tfsdk:"idp"`
+	IdentityProviderURL         types.String  `tfsdk:"tls_idp_url"`
+	TLSClientKey                types.String  `tfsdk:"tls_client_key"`
+	TLSClientCertificate        types.String  `tfsdk:"tls_client_certificate"`
+	MaxRetries                  types.Int64   `tfsdk:"max_retries"`
+	ProxyURL                    types.String  `tfsdk:"proxy_url"`
+	CACertificate               types.String  `tfsdk:"ca_certificate"`
+	CACertificateFile           types.String  `tfsdk:"ca_certificate_file"`
+	TLSMinVersion               types.String  `tfsdk:"tls_min_version"`
+	MaxConcurrentRequests       types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond           types.Float64 `tfsdk:"requests_per_second"`
+	ReadCacheTTL                types.Int64   `tfsdk:"read_cache_ttl"`
+	SerializeSubaccountRequests types.Bool    `tfsdk:"serialize_subaccount_requests"`
+	SessionCache                types.Bool    `tfsdk:"session_cache"`
+	SessionCacheDir             types.String  `tfsdk:"session_cache_dir"`
 }
 
 // Metadata returns the provider type name.
@@ -142,13 +242,47 @@ func (p *btpcliProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	u, err := url.Parse(selectedCLIServerURL) // TODO move to NewV2Client
 
 	if err != nil {
-		resp.Diagnostics.AddError(unableToCreateClient, fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError(unableToCreateClient, errorDetail(err))
 		return
 	}
 
-	client := btpcli.NewClientFacade(btpcli.NewV2ClientWithHttpClient(p.httpClient, u))
+	transportConfig, isCustomTransport := determineTransportConfig(config, resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v2Client := btpcli.NewV2ClientWithHttpClient(p.httpClient, u)
+
+	if isCustomTransport {
+		if v2Client, err = btpcli.NewV2ClientWithTransportConfig(p.httpClient, transportConfig, u); err != nil {
+			resp.Diagnostics.AddError(unableToCreateClient, errorDetail(err))
+			return
+		}
+	}
+
+	client := btpcli.NewClientFacade(v2Client)
 	client.UserAgent = fmt.Sprintf("Terraform/%s terraform-provider-btp/%s", req.TerraformVersion, version.ProviderVersion)
 
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		client.RetryPolicy.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	client.SetRequestLimits(btpcli.RequestLimits{
+		MaxConcurrentRequests:       int(config.MaxConcurrentRequests.ValueInt64()),
+		RequestsPerSecond:           config.RequestsPerSecond.ValueFloat64(),
+		SerializeSubaccountRequests: config.SerializeSubaccountRequests.ValueBool(),
+	})
+
+	if !config.ReadCacheTTL.IsNull() && !config.ReadCacheTTL.IsUnknown() {
+		client.EnableReadCache(time.Duration(config.ReadCacheTTL.ValueInt64()) * time.Second)
+	}
+
+	if client.SessionCache, err = determineSessionCache(config); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("session_cache_dir"), unableToCreateClient, errorDetail(err))
+		return
+	}
+
 	// User may provide an idp to the provider
 	var idp string
 	if config.IdentityProvider.IsUnknown() {
@@ -175,6 +309,19 @@ func (p *btpcliProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		idToken = config.IdToken.ValueString()
 	}
 
+	// User may provide a file containing an id token instead, which is read again on session renewal
+	var idTokenFile string
+	if config.IdTokenFile.IsUnknown() {
+		resp.Diagnostics.AddWarning(unableToCreateClient, "Cannot use unknown value as id token file")
+		return
+	}
+
+	if config.IdTokenFile.IsNull() {
+		idTokenFile = os.Getenv("BTP_IDTOKEN_FILE")
+	} else {
+		idTokenFile = config.IdTokenFile.ValueString()
+	}
+
 	// User must provide a username to the provider unless an id token is given
 	var username string
 	if config.Username.IsUnknown() {
@@ -202,7 +349,7 @@ func (p *btpcliProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	//Determine and execute the login flow depending on the provided parameters
-	switch authFlow := determineAuthFlow(config, idToken); authFlow {
+	switch authFlow := determineAuthFlow(config, idToken, idTokenFile); authFlow {
 	case userPasswordFlow:
 		validateUserPasswordFlow(username, password, resp)
 
@@ -211,7 +358,7 @@ func (p *btpcliProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 
 		if _, err = client.Login(ctx, btpcli.NewLoginRequestWithCustomIDP(idp, config.GlobalAccount.ValueString(), username, password)); err != nil {
-			resp.Diagnostics.AddError(unableToCreateClient, fmt.Sprintf("%s", err))
+			resp.Diagnostics.AddError(unableToCreateClient, errorDetail(err))
 		}
 	case x509Flow:
 
@@ -231,26 +378,33 @@ func (p *btpcliProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 
 		if _, err = client.PasscodeLogin(ctx, passcodeLoginReq); err != nil {
-			resp.Diagnostics.AddError(unableToCreateClient, fmt.Sprintf("%s", err))
+			resp.Diagnostics.AddError(unableToCreateClient, errorDetail(err))
 			return
 		}
 
 	case idTokenFlow:
 		// SAP Internal usage only
 		if _, err = client.IdTokenLogin(ctx, btpcli.NewIdTokenLoginRequest(config.GlobalAccount.ValueString(), idToken)); err != nil {
-			resp.Diagnostics.AddError(unableToCreateClient, fmt.Sprintf("%s", err))
+			resp.Diagnostics.AddError(unableToCreateClient, errorDetail(err))
+		}
+	case idTokenFileFlow:
+		if _, err = client.IdTokenFileLogin(ctx, btpcli.NewIdTokenFileLoginRequest(config.GlobalAccount.ValueString(), idTokenFile)); err != nil {
+			resp.Diagnostics.AddError(unableToCreateClient, errorDetail(err))
 		}
 	default:
 		// No valid login flow
-		resp.Diagnostics.AddError(unableToCreateClient, "No valid login flow found. Please provide either username and password, or an id token, or a client certificate and key.")
+		resp.Diagnostics.AddError(unableToCreateClient, "No valid login flow found. Please provide either username and password, or an id token, or an id token file, or a client certificate and key.")
 	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	appendServerWarnings(client, &resp.Diagnostics)
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 // Resources - Defines provider resources
@@ -258,9 +412,16 @@ func (p *btpcliProvider) Resources(ctx context.Context) []func() resource.Resour
 	betaResources := []func() resource.Resource{
 		//Beta resources should be excluded from sonar scan.
 		//If you add them to production code, remove them from sonar exclusion list
+		newDirectoryLabelsResource,
 		newDirectoryRoleResource,
+		newGlobalaccountResource,
 		newGlobalaccountRoleResource,
+		newSubaccountApiCredentialResource,
+		newSubaccountLabelsResource,
 		newSubaccountRoleResource,
+		newSubaccountServiceBrokerResource,
+		newSubaccountServiceManagerBindingResource,
+		newSubaccountServicePlatformResource,
 	}
 
 	if !p.betaFeaturesEnabled {
@@ -290,6 +451,21 @@ func (p *btpcliProvider) Resources(ctx context.Context) []func() resource.Resour
 	}, betaResources...)
 }
 
+// EphemeralResources - Defines provider ephemeral resources
+func (p *btpcliProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	betaEphemeralResources := []func() ephemeral.EphemeralResource{
+		//Beta ephemeral resources should be excluded from sonar scan.
+		//If you add them to production code, remove them from sonar exclusion list
+		newSubaccountServiceBindingEphemeralResource,
+	}
+
+	if !p.betaFeaturesEnabled {
+		betaEphemeralResources = nil
+	}
+
+	return append([]func() ephemeral.EphemeralResource{}, betaEphemeralResources...)
+}
+
 // DataSources - Defines provider data sources
 func (p *btpcliProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	betaDataSources := []func() datasource.DataSource{
@@ -366,9 +542,11 @@ func (p *btpcliProvider) DataSources(ctx context.Context) []func() datasource.Da
 	}, betaDataSources...)
 }
 
-func determineAuthFlow(config providerData, idToken string) string {
+func determineAuthFlow(config providerData, idToken string, idTokenFile string) string {
 	if len(idToken) > 0 {
 		return idTokenFlow
+	} else if len(idTokenFile) > 0 {
+		return idTokenFileFlow
 	} else if !config.TLSClientKey.IsNull() {
 		return x509Flow
 	} else {
@@ -441,3 +619,100 @@ func validateX509Flow(userName string, identityProviderUrl string, tlsClientKey
 		)
 	}
 }
+
+// determineTransportConfig determines the connection settings from the configuration or the environment. The returned flag is
+// false if no custom connection settings are given.
+func determineTransportConfig(config providerData, resp *provider.ConfigureResponse) (transportConfig btpcli.TransportConfig, isCustom bool) {
+	proxyURL := stringValueOrEnv(config.ProxyURL, "BTP_PROXY_URL")
+	caCertificate := stringValueOrEnv(config.CACertificate, "BTP_CA_CERTIFICATE")
+	caCertificateFile := stringValueOrEnv(config.CACertificateFile, "BTP_CA_CERTIFICATE_FILE")
+	tlsMinVersion := stringValueOrEnv(config.TLSMinVersion, "BTP_TLS_MIN_VERSION")
+
+	if len(proxyURL) > 0 {
+		u, err := url.Parse(proxyURL)
+
+		if err != nil || len(u.Scheme) == 0 || len(u.Host) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Proxy URL",
+				fmt.Sprintf("The provider cannot create the Terraform BTP client as the proxy URL '%s' is invalid. The URL must contain a scheme and a host.", proxyURL),
+			)
+		} else {
+			transportConfig.ProxyURL = u
+		}
+	}
+
+	if len(caCertificate) > 0 && len(caCertificateFile) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_certificate"),
+			"Conflicting CA Certificates",
+			"The provider cannot create the Terraform BTP client as both the ca_certificate and the ca_certificate_file are given. "+
+				"Make sure to provide only one of them, either in the configuration or via the BTP_CA_CERTIFICATE and BTP_CA_CERTIFICATE_FILE environment variables.",
+		)
+	}
+
+	transportConfig.PEMEncodedCACerts = caCertificate
+
+	if len(caCertificateFile) > 0 {
+		pemEncodedCACerts, err := os.ReadFile(caCertificateFile)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_certificate_file"),
+				"Unable to Read CA Certificate File",
+				fmt.Sprintf("The provider cannot create the Terraform BTP client as the CA certificate file cannot be read: %s", err),
+			)
+		}
+
+		transportConfig.PEMEncodedCACerts = string(pemEncodedCACerts)
+	}
+
+	if len(tlsMinVersion) > 0 {
+		version, known := tlsVersions[tlsMinVersion]
+
+		if !known {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tls_min_version"),
+				"Invalid Minimum TLS Version",
+				fmt.Sprintf("The provider cannot create the Terraform BTP client as the minimum TLS version '%s' is not supported. Possible values are: 1.2, 1.3.", tlsMinVersion),
+			)
+		}
+
+		transportConfig.MinTLSVersion = version
+	}
+
+	return transportConfig, len(proxyURL) > 0 || len(caCertificate) > 0 || len(caCertificateFile) > 0 || len(tlsMinVersion) > 0
+}
+
+// determineSessionCache returns the session cache if enabled in the configuration or the environment, otherwise nil
+func determineSessionCache(config providerData) (*btpcli.SessionCache, error) {
+	enabled := config.SessionCache.ValueBool()
+
+	if config.SessionCache.IsNull() {
+		enabled, _ = strconv.ParseBool(os.Getenv("BTP_SESSION_CACHE"))
+	}
+
+	if !enabled {
+		return nil, nil
+	}
+
+	dir := stringValueOrEnv(config.SessionCacheDir, "BTP_SESSION_CACHE_DIR")
+
+	if len(dir) == 0 {
+		var err error
+		if dir, err = btpcli.DefaultSessionCacheDir(); err != nil {
+			return nil, fmt.Errorf("unable to determine the session cache directory: %w", err)
+		}
+	}
+
+	return btpcli.NewSessionCache(dir), nil
+}
+
+// stringValueOrEnv returns the configured value or, if not configured, the value of the given environment variable
+func stringValueOrEnv(value types.String, envVar string) string {
+	if value.IsNull() || value.IsUnknown() {
+		return os.Getenv(envVar)
+	}
+
+	return value.ValueString()
+}
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -13,8 +15,11 @@ import (
 	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	testingResource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
@@ -434,13 +439,148 @@ data "btp_whoami" "me" {}`,
 	})
 }
 
+func TestProvider_ConfigurationWithIdTokenFile(t *testing.T) {
+	t.Run("error path - attribute conflicts with idtoken_file", func(t *testing.T) {
+		testingResource.Test(t, testingResource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []testingResource.TestStep{
+				{
+					Config: `
+provider "btp" {
+	globalaccount  = "ga"
+	username       = "username"
+	idtoken_file   = "idtoken"
+}
+data "btp_whoami" "me" {}`,
+					ExpectError: regexp.MustCompile(`Attribute "username" cannot be specified when "idtoken_file" is specified`),
+				},
+				{
+					Config: `
+provider "btp" {
+	globalaccount  = "ga"
+	idtoken        = "idtoken"
+	idtoken_file   = "idtoken"
+}
+data "btp_whoami" "me" {}`,
+					ExpectError: regexp.MustCompile(`Attribute "idtoken" cannot be specified when "idtoken_file" is specified`),
+				},
+			},
+		})
+	})
+}
+
+func TestProvider_DetermineTransportConfig(t *testing.T) {
+	t.Run("no custom settings", func(t *testing.T) {
+		var resp provider.ConfigureResponse
+
+		_, isCustom := determineTransportConfig(providerData{}, &resp)
+
+		assert.False(t, isCustom)
+		assert.False(t, resp.Diagnostics.HasError())
+	})
+	t.Run("settings from configuration", func(t *testing.T) {
+		var resp provider.ConfigureResponse
+
+		transportConfig, isCustom := determineTransportConfig(providerData{
+			ProxyURL:      types.StringValue("http://proxy.example.com:3128"),
+			CACertificate: types.StringValue("pem"),
+			TLSMinVersion: types.StringValue("1.3"),
+		}, &resp)
+
+		assert.True(t, isCustom)
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "proxy.example.com:3128", transportConfig.ProxyURL.Host)
+		assert.Equal(t, "pem", transportConfig.PEMEncodedCACerts)
+		assert.Equal(t, uint16(tls.VersionTLS13), transportConfig.MinTLSVersion)
+	})
+	t.Run("settings from environment", func(t *testing.T) {
+		caCertificateFile := filepath.Join(t.TempDir(), "ca.pem")
+		assert.NoError(t, os.WriteFile(caCertificateFile, []byte("pem from file"), 0600))
+
+		t.Setenv("BTP_PROXY_URL", "http://proxy.example.com:3128")
+		t.Setenv("BTP_CA_CERTIFICATE_FILE", caCertificateFile)
+
+		var resp provider.ConfigureResponse
+
+		transportConfig, isCustom := determineTransportConfig(providerData{}, &resp)
+
+		assert.True(t, isCustom)
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "proxy.example.com:3128", transportConfig.ProxyURL.Host)
+		assert.Equal(t, "pem from file", transportConfig.PEMEncodedCACerts)
+	})
+	t.Run("error path - invalid proxy url", func(t *testing.T) {
+		var resp provider.ConfigureResponse
+
+		determineTransportConfig(providerData{ProxyURL: types.StringValue("proxy.example.com")}, &resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+	t.Run("error path - conflicting ca certificates", func(t *testing.T) {
+		t.Setenv("BTP_CA_CERTIFICATE_FILE", "ca.pem")
+
+		var resp provider.ConfigureResponse
+
+		determineTransportConfig(providerData{CACertificate: types.StringValue("pem")}, &resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+	t.Run("error path - missing ca certificate file", func(t *testing.T) {
+		var resp provider.ConfigureResponse
+
+		determineTransportConfig(providerData{CACertificateFile: types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))}, &resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
+
+func TestProvider_DetermineSessionCache(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		cache, err := determineSessionCache(providerData{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, cache)
+	})
+	t.Run("enabled in configuration", func(t *testing.T) {
+		t.Setenv("BTP_SESSION_CACHE", "false")
+
+		cache, err := determineSessionCache(providerData{
+			SessionCache:    types.BoolValue(true),
+			SessionCacheDir: types.StringValue(t.TempDir()),
+		})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, cache)
+	})
+	t.Run("enabled via environment", func(t *testing.T) {
+		t.Setenv("BTP_SESSION_CACHE", "true")
+		t.Setenv("BTP_SESSION_CACHE_DIR", t.TempDir())
+
+		cache, err := determineSessionCache(providerData{})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, cache)
+	})
+	t.Run("configuration takes precedence over environment", func(t *testing.T) {
+		t.Setenv("BTP_SESSION_CACHE", "true")
+
+		cache, err := determineSessionCache(providerData{SessionCache: types.BoolValue(false)})
+
+		assert.NoError(t, err)
+		assert.Nil(t, cache)
+	})
+}
+
 func TestProvider_HasResources(t *testing.T) {
 	expectedResources := []string{
 		"btp_directory",
 		"btp_directory_entitlement",
+		//"btp_directory_labels",
 		//"btp_directory_role",
 		"btp_directory_role_collection",
 		"btp_directory_role_collection_assignment",
+		//"btp_globalaccount",
 		"btp_globalaccount_resource_provider",
 		//"btp_globalaccount_role",
 		"btp_globalaccount_role_collection",
@@ -448,14 +588,19 @@ func TestProvider_HasResources(t *testing.T) {
 		"btp_globalaccount_security_settings",
 		"btp_globalaccount_trust_configuration",
 		"btp_subaccount",
+		//"btp_subaccount_api_credential",
 		"btp_subaccount_entitlement",
 		"btp_subaccount_environment_instance",
+		//"btp_subaccount_labels",
 		//"btp_subaccount_role",
 		"btp_subaccount_role_collection",
 		"btp_subaccount_role_collection_assignment",
 		"btp_subaccount_security_settings",
+		//"btp_subaccount_service_broker",
 		"btp_subaccount_service_instance",
 		"btp_subaccount_service_binding",
+		//"btp_subaccount_service_manager_binding",
+		//"btp_subaccount_service_platform",
 		"btp_subaccount_subscription",
 		"btp_subaccount_trust_configuration",
 	}
@@ -474,6 +619,25 @@ func TestProvider_HasResources(t *testing.T) {
 	assert.ElementsMatch(t, expectedResources, registeredResources)
 }
 
+func TestProvider_HasEphemeralResources(t *testing.T) {
+	expectedEphemeralResources := []string{
+		//"btp_subaccount_service_binding",
+	}
+
+	ctx := context.Background()
+	registeredEphemeralResources := []string{}
+
+	for _, ephemeralResourceFunc := range New().(provider.ProviderWithEphemeralResources).EphemeralResources(ctx) {
+		var resp ephemeral.MetadataResponse
+
+		ephemeralResourceFunc().Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "btp"}, &resp)
+
+		registeredEphemeralResources = append(registeredEphemeralResources, resp.TypeName)
+	}
+
+	assert.ElementsMatch(t, expectedEphemeralResources, registeredEphemeralResources)
+}
+
 func TestProvider_HasDatasources(t *testing.T) {
 	expectedDataSources := []string{
 		"btp_directory",
@@ -35,6 +35,11 @@ type directoryResource struct {
 	cli *btpcli.ClientFacade
 }
 
+type directoryResourceType struct {
+	directoryType
+	Admins types.Set `tfsdk:"admins"`
+}
+
 func (rs *directoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = fmt.Sprintf("%s_directory", req.ProviderTypeName)
 }
@@ -77,7 +82,8 @@ __Further documentation:__
 						"<br> 2. Monitor usage and costs on a directory level (costs only available for contracts that use the consumption-based commercial model)"+
 						"<br> 3. Set custom properties and tags to the directory for identification and reporting purposes.") +
 					getFormattedValueAsTableRow("`ENTITLEMENTS (E)`", "Allows the assignment of a quota for services and applications to the directory from the global account quota for distribution to the subaccounts under this directory.") +
-					getFormattedValueAsTableRow("`AUTHORIZATIONS (A)`", "Allows the assignment of users as administrators or viewers of this directory. You must apply this feature in combination with the `ENTITLEMENTS` feature."),
+					getFormattedValueAsTableRow("`AUTHORIZATIONS (A)`", "Allows the assignment of users as administrators or viewers of this directory. You must apply this feature in combination with the `ENTITLEMENTS` feature.") +
+					"\n\nThe features can be changed after the directory has been created. The `DEFAULT` feature cannot be disabled, and the `AUTHORIZATIONS` feature can only be disabled if the directory has no custom role collections.",
 				Optional: true,
 				Computed: true,
 				Validators: []validator.Set{
@@ -122,11 +128,16 @@ __Further documentation:__
 					stringvalidator.RegexMatches(regexp.MustCompile("^[a-z0-9](?:[a-z0-9|-]{0,61}[a-z0-9])?$"), "must only contain letters (a-z), digits (0-9), and hyphens (not at the start or end)"),
 				},
 			},
+			"admins": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Applies only to directories that have the user authorization management feature enabled. The email addresses of the users who are assigned as administrators of the directory. They are assigned to the role collection 'Directory Administrator'. Users who are not managed by this attribute keep their assignments. An empty set unassigns all admins which have been assigned by this attribute. Removing the attribute from the configuration stops managing the admins without unassigning them. The admins are always users of the default identity provider `sap.default`. To assign administrators of a custom identity provider, use the `btp_directory_role_collection_assignment` resource with the `origin` of the identity provider instead.",
+				Optional:            true,
+			},
 			"labels": schema.MapAttribute{
 				ElementType: types.SetType{
 					ElemType: types.StringType,
 				},
-				MarkdownDescription: "Contains information about the labels assigned to a specified global account. Labels are represented in a JSON array of key-value pairs; each key has up to 10 corresponding values.",
+				MarkdownDescription: "Contains information about the labels assigned to a specified global account. Labels are represented in a JSON array of key-value pairs; each key has up to 10 corresponding values. Removing the attribute from the configuration removes all labels of the directory. To manage the labels with the `btp_directory_labels` resource instead, add `labels` to the `ignore_changes` of the `lifecycle` block of the directory.",
 				Optional:            true,
 			},
 			"id": schema.StringAttribute{
@@ -175,7 +186,9 @@ __Further documentation:__
 }
 
 func (rs *directoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state directoryType
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state directoryResourceType
 
 	diags := req.State.Get(ctx, &state)
 
@@ -190,7 +203,8 @@ func (rs *directoryResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	state, diags = directoryValueFrom(ctx, cliRes)
+	// the admins are not returned by the API, so they are kept as they are
+	state.directoryType, diags = directoryValueFrom(ctx, cliRes)
 	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &state)
@@ -198,9 +212,11 @@ func (rs *directoryResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (rs *directoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	const createErrorHeader = "API Error Creating Resource Directory"
 
-	var plan directoryType
+	var plan directoryResourceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -237,20 +253,26 @@ func (rs *directoryResource) Create(ctx context.Context, req resource.CreateRequ
 		args.Features = sortDiretoryFeatures(features)
 	}
 
+	if !plan.Admins.IsNull() && !plan.Admins.IsUnknown() && len(plan.Admins.Elements()) > 0 {
+		var admins []string
+		plan.Admins.ElementsAs(ctx, &admins, false)
+		args.Admins = admins
+	}
+
 	cliRes, _, err := rs.cli.Accounts.Directory.Create(ctx, &args)
 	if err != nil {
-		resp.Diagnostics.AddError(createErrorHeader, fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError(createErrorHeader, errorDetail(err))
 		return
 	}
 
-	plan, diags = directoryValueFrom(ctx, cliRes)
+	plan.directoryType, diags = directoryValueFrom(ctx, cliRes)
 	resp.Diagnostics.Append(diags...)
 
 	createStateConf := &tfutils.StateChangeConf{
 		Pending: []string{cis.StateCreating, cis.StateStarted},
 		Target:  []string{cis.StateOK, cis.StateCreationFailed, cis.StateCanceled},
 		Refresh: func() (interface{}, string, error) {
-			subRes, _, err := rs.cli.Accounts.Directory.Get(ctx, cliRes.Guid)
+			subRes, _, err := rs.cli.Accounts.Directory.Get(btpcli.WithoutReadCache(ctx), cliRes.Guid)
 
 			if err != nil {
 				return subRes, "", err
@@ -265,10 +287,10 @@ func (rs *directoryResource) Create(ctx context.Context, req resource.CreateRequ
 
 	updatedRes, err := createStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(createErrorHeader, fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError(createErrorHeader, errorDetail(err))
 	}
 
-	plan, diags = directoryValueFrom(ctx, updatedRes.(cis.DirectoryResponseObject))
+	plan.directoryType, diags = directoryValueFrom(ctx, updatedRes.(cis.DirectoryResponseObject))
 	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &plan)
@@ -276,10 +298,12 @@ func (rs *directoryResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (rs *directoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	const updateErrorHeader = "API Error Updating Resource Directory"
 
-	var plan directoryType
-	var state directoryType
+	var plan directoryResourceType
+	var state directoryResourceType
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -312,32 +336,87 @@ func (rs *directoryResource) Update(ctx context.Context, req resource.UpdateRequ
 	args.Labels = map[string][]string{}
 	maps.Copy(args.Labels, labels)
 
-	//We do not support the update of features (distinct command in CLI). We raise an error if the user tries to update the features
+	//The features are updated by a distinct command in the CLI, so they are changed before the remaining attributes
 	var planFeatures []string
 	var stateFeatures []string
 
 	plan.Features.ElementsAs(ctx, &planFeatures, false)
 	state.Features.ElementsAs(ctx, &stateFeatures, false)
 
-	if strings.Join(planFeatures, ",") != strings.Join(stateFeatures, ",") {
-		resp.Diagnostics.AddError(updateErrorHeader, "Update of Directory Features is not supported")
-		return
+	planFeatures = sortDiretoryFeatures(planFeatures)
+	stateFeatures = sortDiretoryFeatures(stateFeatures)
+
+	if !plan.Features.IsUnknown() && !slices.Equal(planFeatures, stateFeatures) {
+		enableArgs := btpcli.DirectoryEnableInput{
+			DirectoryId: plan.ID.ValueString(),
+			Features:    planFeatures,
+		}
+
+		if slices.Contains(planFeatures, DirectoryFeatureAuthorizations) && !slices.Contains(stateFeatures, DirectoryFeatureAuthorizations) {
+			if !plan.Subdomain.IsUnknown() && !plan.Subdomain.IsNull() {
+				subdomain := plan.Subdomain.ValueString()
+				enableArgs.Subdomain = &subdomain
+			}
+
+			if !plan.Admins.IsNull() && !plan.Admins.IsUnknown() && len(plan.Admins.Elements()) > 0 {
+				var admins []string
+				plan.Admins.ElementsAs(ctx, &admins, false)
+				enableArgs.Admins = admins
+
+				// the admins are assigned when the authorizations are enabled, so there is nothing left to reconcile
+				state.Admins = plan.Admins
+			}
+		}
+
+		enableRes, _, err := rs.cli.Accounts.Directory.Enable(ctx, &enableArgs)
+		if err != nil {
+			resp.Diagnostics.AddError(updateErrorHeader, errorDetail(err))
+			return
+		}
+
+		enableStateConf := &tfutils.StateChangeConf{
+			Pending: []string{cis.StateUpdating, cis.StateStarted},
+			Target:  []string{cis.StateOK, cis.StateUpdateFailed, cis.StateCanceled},
+			Refresh: func() (interface{}, string, error) {
+				subRes, _, err := rs.cli.Accounts.Directory.Get(btpcli.WithoutReadCache(ctx), enableRes.Guid)
+
+				if err != nil {
+					return subRes, "", err
+				}
+
+				return subRes, subRes.EntityState, nil
+			},
+			Timeout:    10 * time.Minute,
+			Delay:      5 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+
+		enabledRes, err := enableStateConf.WaitForStateContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(updateErrorHeader, errorDetail(err))
+			return
+		}
+
+		if directory := enabledRes.(cis.DirectoryResponseObject); directory.EntityState != cis.StateOK {
+			resp.Diagnostics.AddError(updateErrorHeader, fmt.Sprintf("the features of the directory could not be updated, it is in state %s: %s", directory.EntityState, directory.StateMessage))
+			return
+		}
 	}
 
 	cliRes, _, err := rs.cli.Accounts.Directory.Update(ctx, &args)
 	if err != nil {
-		resp.Diagnostics.AddError(updateErrorHeader, fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError(updateErrorHeader, errorDetail(err))
 		return
 	}
 
-	plan, diags = directoryValueFrom(ctx, cliRes)
+	plan.directoryType, diags = directoryValueFrom(ctx, cliRes)
 	resp.Diagnostics.Append(diags...)
 
 	updateStateConf := &tfutils.StateChangeConf{
 		Pending: []string{cis.StateUpdating, cis.StateStarted},
 		Target:  []string{cis.StateOK, cis.StateUpdateFailed, cis.StateCanceled},
 		Refresh: func() (interface{}, string, error) {
-			subRes, _, err := rs.cli.Accounts.Directory.Get(ctx, cliRes.Guid)
+			subRes, _, err := rs.cli.Accounts.Directory.Get(btpcli.WithoutReadCache(ctx), cliRes.Guid)
 
 			if err != nil {
 				return subRes, "", err
@@ -352,20 +431,121 @@ func (rs *directoryResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	updatedRes, err := updateStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(updateErrorHeader, fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError(updateErrorHeader, errorDetail(err))
 	}
 
-	plan, diags = directoryValueFrom(ctx, updatedRes.(cis.DirectoryResponseObject))
+	plan.directoryType, diags = directoryValueFrom(ctx, updatedRes.(cis.DirectoryResponseObject))
 	resp.Diagnostics.Append(diags...)
 
+	plan.Admins, err = rs.reconcileAdmins(ctx, plan.ID.ValueString(), state.Admins, plan.Admins)
+	if err != nil {
+		resp.Diagnostics.AddError(updateErrorHeader, errorDetail(err))
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
+// reconcileAdmins assigns the added admins to and unassigns the removed admins from the role collection 'Directory Administrator'.
+// It returns the admins which are actually assigned, so that a partially applied change is reflected in the state.
+// The admins are users of the default identity provider, as the create command does not support another origin either.
+func (rs *directoryResource) reconcileAdmins(ctx context.Context, directoryId string, stateAdmins types.Set, planAdmins types.Set) (types.Set, error) {
+	if planAdmins.IsNull() || planAdmins.IsUnknown() || planAdmins.Equal(stateAdmins) {
+		return planAdmins, nil
+	}
+
+	toBeAssigned, toBeUnassigned := diffAdmins(ctx, stateAdmins, planAdmins)
+
+	var assigned []string
+	stateAdmins.ElementsAs(ctx, &assigned, false)
+
+	appliedAdmins := func() types.Set {
+		admins, _ := types.SetValueFrom(ctx, types.StringType, assigned)
+		return admins
+	}
+
+	for _, admin := range toBeAssigned {
+		if _, _, err := rs.cli.Security.RoleCollection.AssignUserByDirectory(ctx, directoryId, directoryAdminRoleCollection, admin, OriginSapDefault); err != nil {
+			return appliedAdmins(), err
+		}
+		assigned = append(assigned, admin)
+	}
+
+	for _, admin := range toBeUnassigned {
+		if _, _, err := rs.cli.Security.RoleCollection.UnassignUserByDirectory(ctx, directoryId, directoryAdminRoleCollection, admin, OriginSapDefault); err != nil {
+			return appliedAdmins(), err
+		}
+		assigned = slices.DeleteFunc(assigned, func(assignedAdmin string) bool { return assignedAdmin == admin })
+	}
+
+	return planAdmins, nil
+}
+
+func (rs *directoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check if the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan directoryResourceType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Features.IsUnknown() {
+		return
+	}
+
+	var planFeatures []string
+	plan.Features.ElementsAs(ctx, &planFeatures, false)
+	planFeatures = sortDiretoryFeatures(planFeatures)
+
+	if slices.Contains(planFeatures, DirectoryFeatureAuthorizations) && !slices.Contains(planFeatures, DirectoryFeatureEntitlements) {
+		resp.Diagnostics.AddAttributeError(path.Root("features"), "Invalid Directory Features", "The AUTHORIZATIONS feature can only be enabled in combination with the ENTITLEMENTS feature.")
+	}
+
+	// the remaining checks only apply to the update of an existing directory
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state directoryResourceType
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateFeatures []string
+	state.Features.ElementsAs(ctx, &stateFeatures, false)
+	stateFeatures = sortDiretoryFeatures(stateFeatures)
+
+	if slices.Contains(stateFeatures, DirectoryFeatureDefault) && !slices.Contains(planFeatures, DirectoryFeatureDefault) {
+		resp.Diagnostics.AddAttributeError(path.Root("features"), "Invalid Directory Features", "The DEFAULT feature is enabled for all directories and cannot be disabled.")
+	}
+
+	if slices.Contains(stateFeatures, DirectoryFeatureAuthorizations) && !slices.Contains(planFeatures, DirectoryFeatureAuthorizations) {
+		roleCollections, _, err := rs.cli.Security.RoleCollection.ListByDirectory(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(path.Root("features"), "Unable to Verify Directory Features", fmt.Sprintf("The role collections of the directory could not be read to verify that the AUTHORIZATIONS feature can be disabled: %s", errorDetail(err)))
+			return
+		}
+
+		var customRoleCollections []string
+		for _, roleCollection := range roleCollections {
+			if !roleCollection.IsReadOnly {
+				customRoleCollections = append(customRoleCollections, roleCollection.Name)
+			}
+		}
+
+		if len(customRoleCollections) > 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("features"), "Invalid Directory Features", fmt.Sprintf("The AUTHORIZATIONS feature cannot be disabled while the directory still has the role collections %s. Delete them before disabling the feature.", strings.Join(customRoleCollections, ", ")))
+		}
+	}
+}
+
 func (rs *directoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	const deleteErrorHeader = "API Error Deleting Resource Directory"
 
-	var state directoryType
+	var state directoryResourceType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -374,7 +554,7 @@ func (rs *directoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	cliRes, _, err := rs.cli.Accounts.Directory.Delete(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(deleteErrorHeader, fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError(deleteErrorHeader, errorDetail(err))
 		return
 	}
 
@@ -382,7 +562,7 @@ func (rs *directoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 		Pending: []string{cis.StateDeleting, cis.StateStarted},
 		Target:  []string{cis.StateOK, cis.StateDeletionFailed, cis.StateCanceled, "DELETED"},
 		Refresh: func() (interface{}, string, error) {
-			subRes, comRes, err := rs.cli.Accounts.Directory.Get(ctx, cliRes.Guid)
+			subRes, comRes, err := rs.cli.Accounts.Directory.Get(btpcli.WithoutReadCache(ctx), cliRes.Guid)
 
 			if comRes.StatusCode == http.StatusNotFound || comRes.StatusCode == http.StatusForbidden {
 				return subRes, "DELETED", nil
@@ -402,7 +582,7 @@ func (rs *directoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 	_, err = deleteStateConf.WaitForStateContext(ctx)
 
 	if err != nil {
-		resp.Diagnostics.AddError(deleteErrorHeader, fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError(deleteErrorHeader, errorDetail(err))
 		return
 	}
 }
@@ -415,19 +595,19 @@ func sortDiretoryFeatures(directoryFeatures []string) []string {
 
 	//Directory Features must be handed to the CLI in a well defined order.
 	//In case Terraform sorts the entries alphabetically or they are handed over in the wrong sequence, we make sure
-	//that they are handed over correctly
+	//that they are handed over correctly. Abbreviated features (D, E, A) are handed over with their full name.
 	directoryFeaturesSorted := []string{}
 
-	if slices.Contains(directoryFeatures, DirectoryFeatureDefault) {
+	if slices.Contains(directoryFeatures, DirectoryFeatureDefault) || slices.Contains(directoryFeatures, "D") {
 		directoryFeaturesSorted = append(directoryFeaturesSorted, DirectoryFeatureDefault)
 	}
 
-	if slices.Contains(directoryFeatures, DirectoryFeatureEntitlements) {
-		directoryFeaturesSorted = append(directoryFeaturesSorted, DirectoryFeatureEntitlements)
+	if slices.Contains(directoryFeatures, DirectoryFeatureAuthorizations) || slices.Contains(directoryFeatures, "A") {
+		directoryFeaturesSorted = append(directoryFeaturesSorted, DirectoryFeatureAuthorizations)
 	}
 
-	if slices.Contains(directoryFeatures, DirectoryFeatureAuthorizations) {
-		directoryFeaturesSorted = append(directoryFeaturesSorted, DirectoryFeatureAuthorizations)
+	if slices.Contains(directoryFeatures, DirectoryFeatureEntitlements) || slices.Contains(directoryFeatures, "E") {
+		directoryFeaturesSorted = append(directoryFeaturesSorted, DirectoryFeatureEntitlements)
 	}
 
 	return directoryFeaturesSorted
@@ -147,6 +147,8 @@ __Further documentation:__
 }
 
 func (rs *directoryEntitlementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state directoryEntitlementType
 
 	diags := req.State.Get(ctx, &state)
@@ -178,10 +180,14 @@ func (rs *directoryEntitlementResource) Read(ctx context.Context, req resource.R
 }
 
 func (rs *directoryEntitlementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	rs.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, "Creating")
 }
 
 func (rs *directoryEntitlementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	rs.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, "Updating")
 }
 
@@ -211,7 +217,7 @@ func (rs *directoryEntitlementResource) createOrUpdate(ctx context.Context, requ
 	}
 
 	if err != nil {
-		responseDiagnostics.AddError(fmt.Sprintf("API Error %s Resource Entitlement (Directory)", action), fmt.Sprintf("%s", err))
+		responseDiagnostics.AddError(fmt.Sprintf("API Error %s Resource Entitlement (Directory)", action), errorDetail(err))
 		return
 	}
 
@@ -220,7 +226,7 @@ func (rs *directoryEntitlementResource) createOrUpdate(ctx context.Context, requ
 		Pending: []string{cis_entitlements.StateStarted, cis_entitlements.StateProcessing},
 		Target:  []string{cis_entitlements.StateOK},
 		Refresh: func() (interface{}, string, error) {
-			entitlement, _, err := rs.cli.Accounts.Entitlement.GetEntitledByDirectory(ctx, plan.DirectoryId.ValueString(), plan.ServiceName.ValueString(), plan.PlanName.ValueString())
+			entitlement, _, err := rs.cli.Accounts.Entitlement.GetEntitledByDirectory(btpcli.WithoutReadCache(ctx), plan.DirectoryId.ValueString(), plan.ServiceName.ValueString(), plan.PlanName.ValueString())
 
 			if err != nil {
 				return nil, "", err
@@ -245,7 +251,7 @@ func (rs *directoryEntitlementResource) createOrUpdate(ctx context.Context, requ
 
 	entitlement, err := createStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		responseDiagnostics.AddError(fmt.Sprintf("API Error %s Resource Entitlement (Directory)", action), fmt.Sprintf("%s", err))
+		responseDiagnostics.AddError(fmt.Sprintf("API Error %s Resource Entitlement (Directory)", action), errorDetail(err))
 		return
 	}
 
@@ -257,6 +263,8 @@ func (rs *directoryEntitlementResource) createOrUpdate(ctx context.Context, requ
 }
 
 func (rs *directoryEntitlementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state directoryEntitlementType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -282,7 +290,7 @@ func (rs *directoryEntitlementResource) Delete(ctx context.Context, req resource
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Entitlement (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Entitlement (Directory)", errorDetail(err))
 		return
 	}
 
@@ -291,7 +299,7 @@ func (rs *directoryEntitlementResource) Delete(ctx context.Context, req resource
 		Target:  []string{"DELETED"},
 		Refresh: func() (interface{}, string, error) {
 
-			entitlement, _, err := rs.cli.Accounts.Entitlement.GetEntitledByDirectory(ctx, state.DirectoryId.ValueString(), state.ServiceName.ValueString(), state.PlanName.ValueString())
+			entitlement, _, err := rs.cli.Accounts.Entitlement.GetEntitledByDirectory(btpcli.WithoutReadCache(ctx), state.DirectoryId.ValueString(), state.ServiceName.ValueString(), state.PlanName.ValueString())
 
 			if reflect.ValueOf(entitlement).IsNil() {
 				return entitlement, "DELETED", nil
@@ -311,7 +319,7 @@ func (rs *directoryEntitlementResource) Delete(ctx context.Context, req resource
 	_, err = deleteStateConf.WaitForStateContext(ctx)
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Entitlement (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Entitlement (Directory)", errorDetail(err))
 		return
 	}
 }
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newDirectoryLabelsResource() resource.Resource {
+	return &directoryLabelsResource{}
+}
+
+type directoryLabelsResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *directoryLabelsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_directory_labels", req.ProviderTypeName)
+}
+
+func (rs *directoryLabelsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *directoryLabelsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages the user-defined labels of a directory independently of the directory itself.
+
+By default, only the label keys configured in this resource are managed and all other labels of the directory are kept. If ` + "`authoritative`" + ` is set, the configured labels replace all labels of the directory.
+
+__Tips:__
+* You must be assigned to the global account admin role, or the directory admin if the directory manages its authorizations.
+* If the directory is managed by the ` + "`btp_directory`" + ` resource, add ` + "`labels`" + ` to the ` + "`ignore_changes`" + ` of its ` + "`lifecycle`" + ` block. Otherwise, the ` + "`btp_directory`" + ` resource removes the labels of this resource on its next update.
+
+__Further documentation:__
+<https://help.sap.com/docs/btp/sap-business-technology-platform/account-model>`,
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the directory.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{ // required by hashicorps terraform plugin testing framework
+				MarkdownDescription: "The ID of the directory.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.SetType{
+					ElemType: types.StringType,
+				},
+				MarkdownDescription: "The labels which are managed by this resource. Each key has up to 10 corresponding values.",
+				Required:            true,
+			},
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: "If true, the configured labels replace all labels of the directory, and labels which are added elsewhere are removed. If false, only the configured label keys are managed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (rs *directoryLabelsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state directoryLabelsType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, rawRes, err := rs.cli.Accounts.Label.ListByDirectory(ctx, state.DirectoryId.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, resp, err, "Resource Labels (Directory)")
+		return
+	}
+
+	// an imported resource has no labels yet and adopts all labels of the directory
+	labels := cliRes.Labels
+	if !state.Labels.IsNull() {
+		labels = managedLabels(cliRes.Labels, labelKeys(state.Labels), state.Authoritative.ValueBool())
+	}
+
+	state.Id = state.DirectoryId
+	state.Labels, diags = types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, labels)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *directoryLabelsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan directoryLabelsType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := rs.applyLabels(ctx, plan.DirectoryId.ValueString(), nil, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Labels (Directory)", errorDetail(err))
+		return
+	}
+
+	plan.Id = plan.DirectoryId
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *directoryLabelsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan, state directoryLabelsType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := rs.applyLabels(ctx, plan.DirectoryId.ValueString(), labelKeys(state.Labels), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Labels (Directory)", errorDetail(err))
+		return
+	}
+
+	plan.Id = plan.DirectoryId
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *directoryLabelsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state directoryLabelsType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// removing the resource is the same as managing no labels at all
+	managedKeys := labelKeys(state.Labels)
+	state.Labels = types.MapNull(types.SetType{ElemType: types.StringType})
+
+	err := rs.applyLabels(ctx, state.DirectoryId.ValueString(), managedKeys, state)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Labels (Directory)", errorDetail(err))
+		return
+	}
+}
+
+func (rs *directoryLabelsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("directory_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), false)...)
+}
+
+// applyLabels updates the labels of the directory so that the planned labels are set and the previously managed ones, which are no longer planned, are removed
+func (rs *directoryLabelsResource) applyLabels(ctx context.Context, directoryId string, managedKeys []string, plan directoryLabelsType) error {
+	current, _, err := rs.cli.Accounts.Label.ListByDirectory(btpcli.WithoutReadCache(ctx), directoryId)
+	if err != nil {
+		return err
+	}
+
+	var planned map[string][]string
+	plan.Labels.ElementsAs(ctx, &planned, false)
+
+	_, _, err = rs.cli.Accounts.Label.UpdateByDirectory(ctx, directoryId, labelsToApply(current.Labels, managedKeys, planned, plan.Authoritative.ValueBool()))
+	return err
+}
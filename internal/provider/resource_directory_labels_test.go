@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestResourceDirectoryLabels(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - labels of a directory which ignores its labels", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_directory_labels.with_directory")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) +
+						hclResourceDirectoryWithIgnoredLabels("uut", "integration-test-labels", "a directory with labels") +
+						hclResourceDirectoryLabels("uut", "btp_directory.uut.id", "platform", false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPair("btp_directory_labels.uut", "directory_id", "btp_directory.uut", "id"),
+						resource.TestCheckResourceAttr("btp_directory_labels.uut", "labels.%", "1"),
+						resource.TestCheckResourceAttr("btp_directory_labels.uut", "labels.team.0", "platform"),
+						resource.TestCheckResourceAttr("btp_directory_labels.uut", "authoritative", "false"),
+					),
+				},
+				{
+					// an update of the directory which ignores its labels must not remove the labels managed by the labels resource
+					Config: hclProviderFor(user) +
+						hclResourceDirectoryWithIgnoredLabels("uut", "integration-test-labels", "an updated directory with labels") +
+						hclResourceDirectoryLabels("uut", "btp_directory.uut.id", "platform", false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_directory.uut", "description", "an updated directory with labels"),
+						resource.TestCheckResourceAttr("btp_directory.uut", "labels.team.0", "platform"),
+						resource.TestCheckResourceAttr("btp_directory_labels.uut", "labels.team.0", "platform"),
+					),
+				},
+				{
+					Config: hclProviderFor(user) +
+						hclResourceDirectoryWithIgnoredLabels("uut", "integration-test-labels", "an updated directory with labels") +
+						hclResourceDirectoryLabels("uut", "btp_directory.uut.id", "security", true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_directory_labels.uut", "labels.%", "1"),
+						resource.TestCheckResourceAttr("btp_directory_labels.uut", "labels.team.0", "security"),
+						resource.TestCheckResourceAttr("btp_directory_labels.uut", "authoritative", "true"),
+					),
+				},
+				{
+					ResourceName:            "btp_directory_labels.uut",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"authoritative"},
+				},
+			},
+		})
+	})
+	t.Run("error path - directory_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceDirectoryLabels("uut", `"this-is-not-a-uuid"`, "platform", false),
+					ExpectError: regexp.MustCompile(`Attribute directory_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+}
+
+func hclResourceDirectoryWithIgnoredLabels(resourceName string, displayName string, description string) string {
+	return fmt.Sprintf(`resource "btp_directory" "%s" {
+        name        = "%s"
+        description = "%s"
+        lifecycle {
+            ignore_changes = [labels]
+        }
+    }`, resourceName, displayName, description)
+}
+
+func hclResourceDirectoryLabels(resourceName string, directoryIdReference string, team string, authoritative bool) string {
+	template := `
+resource "btp_directory_labels" "%s" {
+    directory_id  = %s
+    labels = {
+        "team" = ["%s"]
+    }
+    authoritative = %t
+}`
+	return fmt.Sprintf(template, resourceName, directoryIdReference, team, authoritative)
+}
@@ -117,6 +117,8 @@ __Further documentation:__
 }
 
 func (rs *directoryRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state directoryRoleType
 
 	diags := req.State.Get(ctx, &state)
@@ -133,7 +135,7 @@ func (rs *directoryRoleResource) Read(ctx context.Context, req resource.ReadRequ
 		state.RoleTemplateName.ValueString(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role (Directory)", errorDetail(err))
 		return
 	}
 
@@ -153,6 +155,8 @@ func (rs *directoryRoleResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (rs *directoryRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan directoryRoleType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -168,7 +172,7 @@ func (rs *directoryRoleResource) Create(ctx context.Context, req resource.Create
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Role (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Role (Directory)", errorDetail(err))
 		return
 	}
 
@@ -185,6 +189,8 @@ func (rs *directoryRoleResource) Create(ctx context.Context, req resource.Create
 }
 
 func (rs *directoryRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan directoryRoleType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -200,6 +206,8 @@ func (rs *directoryRoleResource) Update(ctx context.Context, req resource.Update
 }
 
 func (rs *directoryRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state directoryRoleType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -209,7 +217,7 @@ func (rs *directoryRoleResource) Delete(ctx context.Context, req resource.Delete
 
 	_, _, err := rs.cli.Security.Role.DeleteByDirectory(ctx, state.DirectoryId.ValueString(), state.Name.ValueString(), state.RoleTemplateAppId.ValueString(), state.RoleTemplateName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Role (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Role (Directory)", errorDetail(err))
 		return
 	}
 }
@@ -123,6 +123,8 @@ __Further documentation:__
 }
 
 func (rs *directoryRoleCollectionType) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state directoryRoleCollectionTypeConfig
 
 	diags := req.State.Get(ctx, &state)
@@ -160,6 +162,8 @@ func (rs *directoryRoleCollectionType) Read(ctx context.Context, req resource.Re
 }
 
 func (rs *directoryRoleCollectionType) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan directoryRoleCollectionTypeConfig
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -169,7 +173,7 @@ func (rs *directoryRoleCollectionType) Create(ctx context.Context, req resource.
 
 	cliRes, _, err := rs.cli.Security.RoleCollection.CreateByDirectory(ctx, plan.DirectoryId.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Role Collection (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Role Collection (Directory)", errorDetail(err))
 		return
 	}
 
@@ -177,7 +181,7 @@ func (rs *directoryRoleCollectionType) Create(ctx context.Context, req resource.
 		_, err := rs.cli.Security.Role.AddByDirectory(ctx, plan.DirectoryId.ValueString(), plan.Name.ValueString(), role.Name.ValueString(), role.RoleTemplateAppId.ValueString(), role.RoleTemplateName.ValueString())
 
 		if err != nil {
-			resp.Diagnostics.AddError("API Error Adding Role To Role Collection (Directory)", fmt.Sprintf("%s", err))
+			resp.Diagnostics.AddError("API Error Adding Role To Role Collection (Directory)", errorDetail(err))
 		}
 	}
 
@@ -192,6 +196,8 @@ func (rs *directoryRoleCollectionType) Create(ctx context.Context, req resource.
 }
 
 func (rs *directoryRoleCollectionType) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state directoryRoleCollectionTypeConfig
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -207,7 +213,7 @@ func (rs *directoryRoleCollectionType) Update(ctx context.Context, req resource.
 
 	_, _, err := rs.cli.Security.RoleCollection.UpdateByDirectory(ctx, plan.DirectoryId.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Role Collection (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Role Collection (Directory)", errorDetail(err))
 		return
 	}
 
@@ -216,7 +222,7 @@ func (rs *directoryRoleCollectionType) Update(ctx context.Context, req resource.
 		_, err := rs.cli.Security.Role.RemoveByDirectory(ctx, plan.DirectoryId.ValueString(), plan.Name.ValueString(), role.Name.ValueString(), role.RoleTemplateAppId.ValueString(), role.RoleTemplateName.ValueString())
 
 		if err != nil {
-			resp.Diagnostics.AddError("API Error Removing Role From Role Collection (Directory)", fmt.Sprintf("%s", err))
+			resp.Diagnostics.AddError("API Error Removing Role From Role Collection (Directory)", errorDetail(err))
 		}
 	}
 
@@ -225,13 +231,13 @@ func (rs *directoryRoleCollectionType) Update(ctx context.Context, req resource.
 		_, err := rs.cli.Security.Role.AddByDirectory(ctx, plan.DirectoryId.ValueString(), plan.Name.ValueString(), role.Name.ValueString(), role.RoleTemplateAppId.ValueString(), role.RoleTemplateName.ValueString())
 
 		if err != nil {
-			resp.Diagnostics.AddError("API Error Adding Role From Role Collection (Directory)", fmt.Sprintf("%s", err))
+			resp.Diagnostics.AddError("API Error Adding Role From Role Collection (Directory)", errorDetail(err))
 		}
 	}
 
 	cliRes, _, err := rs.cli.Security.RoleCollection.GetByDirectory(ctx, plan.DirectoryId.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role Collection (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role Collection (Directory)", errorDetail(err))
 		return
 	}
 
@@ -253,6 +259,8 @@ func (rs *directoryRoleCollectionType) Update(ctx context.Context, req resource.
 }
 
 func (rs *directoryRoleCollectionType) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state directoryRoleCollectionTypeConfig
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -262,7 +270,7 @@ func (rs *directoryRoleCollectionType) Delete(ctx context.Context, req resource.
 
 	_, _, err := rs.cli.Security.RoleCollection.DeleteByDirectory(ctx, state.DirectoryId.ValueString(), state.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection (Directory)", errorDetail(err))
 		return
 	}
 }
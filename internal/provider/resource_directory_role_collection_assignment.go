@@ -140,6 +140,8 @@ func (rs *directoryRoleCollectionAssignmentResource) Schema(_ context.Context, _
 }
 
 func (rs *directoryRoleCollectionAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state directoryRoleCollectionAssignmentType
 
 	diags := req.State.Get(ctx, &state)
@@ -155,6 +157,8 @@ func (rs *directoryRoleCollectionAssignmentResource) Read(ctx context.Context, r
 }
 
 func (rs *directoryRoleCollectionAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan directoryRoleCollectionAssignmentType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -175,7 +179,7 @@ func (rs *directoryRoleCollectionAssignmentResource) Create(ctx context.Context,
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Role Collection Assignment (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Role Collection Assignment (Directory)", errorDetail(err))
 		return
 	}
 
@@ -187,6 +191,8 @@ func (rs *directoryRoleCollectionAssignmentResource) Create(ctx context.Context,
 }
 
 func (rs *directoryRoleCollectionAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan directoryRoleCollectionAssignmentType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -205,6 +211,8 @@ func (rs *directoryRoleCollectionAssignmentResource) Update(ctx context.Context,
 }
 
 func (rs *directoryRoleCollectionAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state directoryRoleCollectionAssignmentType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -225,7 +233,7 @@ func (rs *directoryRoleCollectionAssignmentResource) Delete(ctx context.Context,
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection Assignment (Directory)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection Assignment (Directory)", errorDetail(err))
 		return
 	}
 }
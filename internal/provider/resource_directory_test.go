@@ -1,11 +1,21 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
 )
 
 func TestResourceDirectory(t *testing.T) {
@@ -135,8 +145,8 @@ func TestResourceDirectory(t *testing.T) {
 		})
 	})
 
-	t.Run("error path - change directory features", func(t *testing.T) {
-		rec, user := setupVCR(t, "fixtures/resource_directory.error_change_features")
+	t.Run("happy path - enable and disable directory features", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_directory.change_features")
 		defer stopQuietly(rec)
 
 		resource.Test(t, resource.TestCase{
@@ -147,16 +157,92 @@ func TestResourceDirectory(t *testing.T) {
 					Config: hclProviderFor(user) + hclResourceDirectory("uut", "my-new-directory", "This is a new directory"),
 					Check: resource.ComposeAggregateTestCheckFunc(
 						resource.TestMatchResourceAttr("btp_directory.uut", "id", regexpValidUUID),
-						resource.TestMatchResourceAttr("btp_directory.uut", "created_date", regexpValidRFC3999Format),
-						resource.TestMatchResourceAttr("btp_directory.uut", "last_modified", regexpValidRFC3999Format),
-						resource.TestMatchResourceAttr("btp_directory.uut", "parent_id", regexpValidUUID),
 						resource.TestCheckResourceAttr("btp_directory.uut", "name", "my-new-directory"),
-						resource.TestCheckResourceAttr("btp_directory.uut", "description", "This is a new directory"),
+						resource.TestCheckResourceAttr("btp_directory.uut", "features.#", "1"),
+						resource.TestCheckTypeSetElemAttr("btp_directory.uut", "features.*", "DEFAULT"),
+					),
+				},
+				{
+					// the features are enabled for the existing directory instead of replacing it
+					Config: hclProviderFor(user) + hclResourceDirectoryWithFeatures("uut", "my-new-directory", "This is an updated directory"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("btp_directory.uut", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_directory.uut", "description", "This is an updated directory"),
+						resource.TestCheckResourceAttr("btp_directory.uut", "features.#", "3"),
+						resource.TestCheckTypeSetElemAttr("btp_directory.uut", "features.*", "ENTITLEMENTS"),
+						resource.TestCheckTypeSetElemAttr("btp_directory.uut", "features.*", "AUTHORIZATIONS"),
+					),
+				},
+				{
+					// the directory has no custom role collections, so the authorizations can be disabled again
+					Config: hclProviderFor(user) + hclResourceDirectoryWithEntitlements("uut", "my-new-directory", "This is an updated directory"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("btp_directory.uut", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_directory.uut", "features.#", "2"),
+						resource.TestCheckTypeSetElemAttr("btp_directory.uut", "features.*", "DEFAULT"),
+						resource.TestCheckTypeSetElemAttr("btp_directory.uut", "features.*", "ENTITLEMENTS"),
 					),
 				},
 				{
-					Config:      hclProviderFor(user) + hclResourceDirectoryWithFeatures("uut", "my-new-directory", "This is an updated directory"),
-					ExpectError: regexp.MustCompile(`Update of Directory Features is not supported`),
+					ResourceName:      "btp_directory.uut",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+
+	t.Run("error path - disable the default feature", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_directory.error_disable_default_feature")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceDirectoryWithFeatures("uut", "my-new-directory", "This is a new directory"),
+				},
+				{
+					Config:      hclProviderFor(user) + hclResourceDirectoryWithGivenFeatures("uut", "my-new-directory", "This is a new directory", `["ENTITLEMENTS","AUTHORIZATIONS"]`),
+					ExpectError: regexp.MustCompile(`The DEFAULT feature is enabled for all directories and cannot be\s+disabled`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - authorizations without entitlements", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_directory.error_authorizations_without_entitlements")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclProviderFor(user) + hclResourceDirectoryWithGivenFeatures("uut", "my-new-directory", "This is a new directory", `["DEFAULT","AUTHORIZATIONS"]`),
+					ExpectError: regexp.MustCompile(`The AUTHORIZATIONS feature can only be enabled in combination with the\s+ENTITLEMENTS feature`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - features not valid", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceDirectoryWithGivenFeatures("uut", "my-new-directory", "This is a new directory", `["DEFAULT","QUOTAS"]`),
+					ExpectError: regexp.MustCompile(`value must be one of`),
 				},
 			},
 		})
@@ -178,6 +264,18 @@ func hclResourceDirectoryWithFeatures(resourceName string, displayName string, d
     }`, resourceName, displayName, description)
 }
 
+func hclResourceDirectoryWithEntitlements(resourceName string, displayName string, description string) string {
+	return hclResourceDirectoryWithGivenFeatures(resourceName, displayName, description, `["DEFAULT","ENTITLEMENTS"]`)
+}
+
+func hclResourceDirectoryWithGivenFeatures(resourceName string, displayName string, description string, features string) string {
+	return fmt.Sprintf(`resource "btp_directory" "%s" {
+        name        = "%s"
+        description = "%s"
+		features    = %s
+    }`, resourceName, displayName, description, features)
+}
+
 func hclResourceDirectoryAll(resourceName string, displayName string, description string) string {
 	return fmt.Sprintf(`resource "btp_directory" "%s" {
         name        = "%s"
@@ -186,3 +284,89 @@ func hclResourceDirectoryAll(resourceName string, displayName string, descriptio
 		labels = {"foo" = ["bar"]}
     }`, resourceName, displayName, description)
 }
+
+func TestSortDirectoryFeatures(t *testing.T) {
+	assert.Equal(t, []string{"DEFAULT", "AUTHORIZATIONS", "ENTITLEMENTS"}, sortDiretoryFeatures([]string{"ENTITLEMENTS", "DEFAULT", "AUTHORIZATIONS"}))
+	assert.Equal(t, []string{"DEFAULT", "ENTITLEMENTS"}, sortDiretoryFeatures([]string{"E", "D"}))
+	assert.Equal(t, []string{}, sortDiretoryFeatures(nil))
+}
+
+func TestDirectoryResource_ModifyPlanFeatures(t *testing.T) {
+	directoryId := "5357bda0-8651-4eab-a69d-12d282bc3247"
+
+	directoryWith := func(t *testing.T, uut *directoryResource, features ...string) tfsdk.State {
+		featureSet, _ := types.SetValueFrom(context.TODO(), types.StringType, features)
+		return newTestState(t, uut, map[string]attr.Value{
+			"id":       types.StringValue(directoryId),
+			"name":     types.StringValue("my-directory"),
+			"features": featureSet,
+		})
+	}
+	modifyPlan := func(t *testing.T, uut *directoryResource, state tfsdk.State, features ...string) *fwresource.ModifyPlanResponse {
+		planned := directoryWith(t, uut, features...)
+		plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		uut.ModifyPlan(context.TODO(), fwresource.ModifyPlanRequest{State: state, Plan: plan, Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}, resp)
+		return resp
+	}
+	newResource := func(t *testing.T, roleCollections string) *directoryResource {
+		return &directoryResource{cli: newLoggedInClientFacade(t, func(w http.ResponseWriter, r *http.Request) {
+			if len(roleCollections) == 0 {
+				w.Header().Set(btpcli.HeaderCLIBackendStatus, "500")
+				fmt.Fprintf(w, `{"error":"role collections could not be read"}`)
+				return
+			}
+
+			fmt.Fprint(w, roleCollections)
+		})}
+	}
+
+	t.Run("happy path - features are enabled", func(t *testing.T) {
+		uut := newResource(t, "[]")
+
+		resp := modifyPlan(t, uut, directoryWith(t, uut, "DEFAULT"), "DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS")
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	})
+	t.Run("happy path - authorizations are disabled without custom role collections", func(t *testing.T) {
+		uut := newResource(t, `[{"name":"Directory Administrator","isReadOnly":true},{"name":"Directory Viewer","isReadOnly":true}]`)
+
+		resp := modifyPlan(t, uut, directoryWith(t, uut, "DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"), "DEFAULT", "ENTITLEMENTS")
+
+		assert.Empty(t, resp.Diagnostics)
+	})
+	t.Run("error path - authorizations without entitlements", func(t *testing.T) {
+		uut := newResource(t, "[]")
+
+		resp := modifyPlan(t, uut, directoryWith(t, uut, "DEFAULT"), "DEFAULT", "AUTHORIZATIONS")
+
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "The AUTHORIZATIONS feature can only be enabled in combination with the ENTITLEMENTS feature.")
+	})
+	t.Run("error path - default feature is disabled", func(t *testing.T) {
+		uut := newResource(t, "[]")
+
+		resp := modifyPlan(t, uut, directoryWith(t, uut, "DEFAULT", "ENTITLEMENTS"), "ENTITLEMENTS")
+
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "The DEFAULT feature is enabled for all directories and cannot be disabled.")
+	})
+	t.Run("error path - authorizations are disabled with custom role collections", func(t *testing.T) {
+		uut := newResource(t, `[{"name":"Directory Administrator","isReadOnly":true},{"name":"My Role Collection","isReadOnly":false}]`)
+
+		resp := modifyPlan(t, uut, directoryWith(t, uut, "DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"), "DEFAULT", "ENTITLEMENTS")
+
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "The AUTHORIZATIONS feature cannot be disabled while the directory still has the role collections My Role Collection.")
+	})
+	t.Run("error path - role collections can't be read", func(t *testing.T) {
+		uut := newResource(t, "")
+
+		resp := modifyPlan(t, uut, directoryWith(t, uut, "DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"), "DEFAULT", "ENTITLEMENTS")
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+		assert.Contains(t, resp.Diagnostics.Warnings()[0].Summary(), "Unable to Verify Directory Features")
+	})
+}
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+)
+
+func newGlobalaccountResource() resource.Resource {
+	return &globalaccountResource{}
+}
+
+type globalaccountResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *globalaccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_globalaccount", req.ProviderTypeName)
+}
+
+func (rs *globalaccountResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *globalaccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages the metadata of the global account the provider is configured for.
+
+The global account is not created by this resource, but the existing one is adopted. When the resource is destroyed, the global account is only removed from the Terraform state.
+
+__Tip:__
+You must be assigned to the global account admin role.
+
+__Further documentation:__
+<https://help.sap.com/docs/btp/sap-business-technology-platform/account-model>`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the global account.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the global account.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the global account.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usage": schema.StringAttribute{
+				MarkdownDescription: "The intended purpose of the global account. Possible values are: \n" +
+					getFormattedValueAsTableRow("usage", "description") +
+					getFormattedValueAsTableRow("---", "---") +
+					getFormattedValueAsTableRow("`Development`", "For testing development.") +
+					getFormattedValueAsTableRow("`Testing`", "For testing development.") +
+					getFormattedValueAsTableRow("`Demo`", "For creating demos.") +
+					getFormattedValueAsTableRow("`Production`", "For delivering a service in a production landscape."),
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Development", "Testing", "Demo", "Production"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"commercial_model": schema.StringAttribute{
+				MarkdownDescription: "The type of the commercial contract that was signed.",
+				Computed:            true,
+			},
+			"contract_status": schema.StringAttribute{
+				MarkdownDescription: "The status of the customer contract and its associated root global account.",
+				Computed:            true,
+			},
+			"geo_access": schema.StringAttribute{
+				MarkdownDescription: "The geographic locations from where the global account can be accessed.",
+				Computed:            true,
+			},
+			"license_type": schema.StringAttribute{
+				MarkdownDescription: "The type of license for the global account. The license type affects the scope of functions of the account.",
+				Computed:            true,
+			},
+			"subdomain": schema.StringAttribute{
+				MarkdownDescription: "The subdomain is part of the path used to access the authorization tenant of the global account.",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The current state of the global account.",
+				Computed:            true,
+			},
+			"created_date": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was last modified in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (rs *globalaccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state globalaccountResourceType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, _, err := rs.cli.Accounts.GlobalAccount.Get(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Resource Global Account", errorDetail(err))
+		return
+	}
+
+	state = globalaccountResourceValueFrom(cliRes)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *globalaccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan globalaccountResourceType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The global account already exists, so it is adopted and only updated if the configuration deviates
+	cliRes, _, err := rs.cli.Accounts.GlobalAccount.Get(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Global Account", errorDetail(err))
+		return
+	}
+
+	current := globalaccountResourceValueFrom(cliRes)
+
+	if (!plan.Name.IsUnknown() && !plan.Name.Equal(current.Name)) ||
+		(!plan.Description.IsUnknown() && !plan.Description.Equal(current.Description)) ||
+		(!plan.Usage.IsUnknown() && !plan.Usage.Equal(current.Usage)) {
+		cliRes, _, err = rs.cli.Accounts.GlobalAccount.Update(ctx, globalaccountUpdateInputFrom(plan))
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Creating Resource Global Account", errorDetail(err))
+			return
+		}
+	}
+
+	state := globalaccountResourceValueFrom(cliRes)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *globalaccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan globalaccountResourceType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, _, err := rs.cli.Accounts.GlobalAccount.Update(ctx, globalaccountUpdateInputFrom(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Global Account", errorDetail(err))
+		return
+	}
+
+	state := globalaccountResourceValueFrom(cliRes)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *globalaccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	// The global account cannot be deleted via the provider, so it is only removed from the state
+}
+
+func (rs *globalaccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cliRes, _, err := rs.cli.Accounts.GlobalAccount.Get(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Importing Resource Global Account", errorDetail(err))
+		return
+	}
+
+	if req.ID != cliRes.Guid {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the ID of the global account the provider is configured for (%s). Got: %q", cliRes.Guid, req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func globalaccountUpdateInputFrom(plan globalaccountResourceType) *btpcli.GlobalAccountUpdateInput {
+	args := &btpcli.GlobalAccountUpdateInput{}
+
+	if !plan.Name.IsUnknown() {
+		name := plan.Name.ValueString()
+		args.DisplayName = &name
+	}
+
+	if !plan.Description.IsUnknown() {
+		description := plan.Description.ValueString()
+		args.Description = &description
+	}
+
+	if !plan.Usage.IsUnknown() && !plan.Usage.IsNull() {
+		usage := plan.Usage.ValueString()
+		args.Usage = &usage
+	}
+
+	return args
+}
@@ -102,6 +102,8 @@ __Further documentation:__
 }
 
 func (rs *resourceGlobalaccountProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountResourceProviderType
 
 	diags := req.State.Get(ctx, &state)
@@ -125,6 +127,8 @@ func (rs *resourceGlobalaccountProviderResource) Read(ctx context.Context, req r
 }
 
 func (rs *resourceGlobalaccountProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan globalaccountResourceProviderType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -140,7 +144,7 @@ func (rs *resourceGlobalaccountProviderResource) Create(ctx context.Context, req
 		Configuration: plan.Configuration.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Resource Provider (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Resource Provider (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -152,6 +156,8 @@ func (rs *resourceGlobalaccountProviderResource) Create(ctx context.Context, req
 }
 
 func (rs *resourceGlobalaccountProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan globalaccountResourceProviderType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -167,7 +173,7 @@ func (rs *resourceGlobalaccountProviderResource) Update(ctx context.Context, req
 		Configuration: plan.Configuration.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Resource Provider (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Resource Provider (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -179,6 +185,8 @@ func (rs *resourceGlobalaccountProviderResource) Update(ctx context.Context, req
 }
 
 func (rs *resourceGlobalaccountProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountResourceProviderType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -188,7 +196,7 @@ func (rs *resourceGlobalaccountProviderResource) Delete(ctx context.Context, req
 
 	_, _, err := rs.cli.Accounts.ResourceProvider.Delete(ctx, state.Provider.ValueString(), state.TechnicalName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Resource Provider (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Resource Provider (Global Account)", errorDetail(err))
 		return
 	}
 }
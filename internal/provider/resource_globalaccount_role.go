@@ -65,6 +65,8 @@ __Further documentation:__
 }
 
 func (rs *globalaccountRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountRoleType
 
 	diags := req.State.Get(ctx, &state)
@@ -80,7 +82,7 @@ func (rs *globalaccountRoleResource) Read(ctx context.Context, req resource.Read
 		state.RoleTemplateName.ValueString(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -92,6 +94,8 @@ func (rs *globalaccountRoleResource) Read(ctx context.Context, req resource.Read
 }
 
 func (rs *globalaccountRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan globalaccountRoleType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -105,7 +109,7 @@ func (rs *globalaccountRoleResource) Create(ctx context.Context, req resource.Cr
 		RoleTemplateName: plan.RoleTemplateName.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Role (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Role (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -117,6 +121,8 @@ func (rs *globalaccountRoleResource) Create(ctx context.Context, req resource.Cr
 }
 
 func (rs *globalaccountRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan globalaccountRoleType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -131,6 +137,8 @@ func (rs *globalaccountRoleResource) Update(ctx context.Context, req resource.Up
 }
 
 func (rs *globalaccountRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountRoleType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -140,7 +148,7 @@ func (rs *globalaccountRoleResource) Delete(ctx context.Context, req resource.De
 
 	_, _, err := rs.cli.Security.Role.DeleteByGlobalAccount(ctx, state.Name.ValueString(), state.RoleTemplateAppId.ValueString(), state.RoleTemplateName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Role (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Role (Global Account)", errorDetail(err))
 		return
 	}
 }
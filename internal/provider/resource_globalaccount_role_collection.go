@@ -114,6 +114,8 @@ __Further documentation:__
 }
 
 func (rs *globalaccountRoleCollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountRoleCollectionType
 
 	diags := req.State.Get(ctx, &state)
@@ -151,6 +153,8 @@ func (rs *globalaccountRoleCollectionResource) Read(ctx context.Context, req res
 }
 
 func (rs *globalaccountRoleCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan globalaccountRoleCollectionType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -160,7 +164,7 @@ func (rs *globalaccountRoleCollectionResource) Create(ctx context.Context, req r
 
 	cliRes, _, err := rs.cli.Security.RoleCollection.CreateByGlobalAccount(ctx, plan.Name.ValueString(), plan.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Role Collection (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Role Collection (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -173,7 +177,7 @@ func (rs *globalaccountRoleCollectionResource) Create(ctx context.Context, req r
 		_, err := rs.cli.Security.Role.AddByGlobalAccount(ctx, plan.Name.ValueString(), role.Name.ValueString(), role.RoleTemplateAppId.ValueString(), role.RoleTemplateName.ValueString())
 
 		if err != nil {
-			resp.Diagnostics.AddError("API Error Adding Role To Role Collection (Global Account)", fmt.Sprintf("%s", err))
+			resp.Diagnostics.AddError("API Error Adding Role To Role Collection (Global Account)", errorDetail(err))
 		}
 	}
 
@@ -182,6 +186,8 @@ func (rs *globalaccountRoleCollectionResource) Create(ctx context.Context, req r
 }
 
 func (rs *globalaccountRoleCollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountRoleCollectionType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -197,7 +203,7 @@ func (rs *globalaccountRoleCollectionResource) Update(ctx context.Context, req r
 
 	_, _, err := rs.cli.Security.RoleCollection.UpdateByGlobalAccount(ctx, plan.Name.ValueString(), plan.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Role Collection (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Role Collection (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -206,7 +212,7 @@ func (rs *globalaccountRoleCollectionResource) Update(ctx context.Context, req r
 		_, err := rs.cli.Security.Role.RemoveByGlobalAccount(ctx, plan.Name.ValueString(), role.Name.ValueString(), role.RoleTemplateAppId.ValueString(), role.RoleTemplateName.ValueString())
 
 		if err != nil {
-			resp.Diagnostics.AddError("API Error Removing Role From Role Collection (Global Account)", fmt.Sprintf("%s", err))
+			resp.Diagnostics.AddError("API Error Removing Role From Role Collection (Global Account)", errorDetail(err))
 		}
 	}
 
@@ -215,13 +221,13 @@ func (rs *globalaccountRoleCollectionResource) Update(ctx context.Context, req r
 		_, err := rs.cli.Security.Role.AddByGlobalAccount(ctx, plan.Name.ValueString(), role.Name.ValueString(), role.RoleTemplateAppId.ValueString(), role.RoleTemplateName.ValueString())
 
 		if err != nil {
-			resp.Diagnostics.AddError("API Error Adding Role From Role Collection (Global Account)", fmt.Sprintf("%s", err))
+			resp.Diagnostics.AddError("API Error Adding Role From Role Collection (Global Account)", errorDetail(err))
 		}
 	}
 
 	cliRes, _, err := rs.cli.Security.RoleCollection.GetByGlobalAccount(ctx, plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role Collection (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role Collection (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -243,6 +249,8 @@ func (rs *globalaccountRoleCollectionResource) Update(ctx context.Context, req r
 }
 
 func (rs *globalaccountRoleCollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountRoleCollectionType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -252,7 +260,7 @@ func (rs *globalaccountRoleCollectionResource) Delete(ctx context.Context, req r
 
 	_, _, err := rs.cli.Security.RoleCollection.DeleteByGlobalAccount(ctx, state.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection (Global Account)", errorDetail(err))
 		return
 	}
 }
@@ -128,6 +128,8 @@ func (rs *globalaccountRoleCollectionAssignmentResource) Schema(_ context.Contex
 }
 
 func (rs *globalaccountRoleCollectionAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountRoleCollectionAssignmentType
 
 	diags := req.State.Get(ctx, &state)
@@ -143,6 +145,8 @@ func (rs *globalaccountRoleCollectionAssignmentResource) Read(ctx context.Contex
 }
 
 func (rs *globalaccountRoleCollectionAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan globalaccountRoleCollectionAssignmentType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -163,7 +167,7 @@ func (rs *globalaccountRoleCollectionAssignmentResource) Create(ctx context.Cont
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Role Collection Assignment (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Role Collection Assignment (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -175,6 +179,8 @@ func (rs *globalaccountRoleCollectionAssignmentResource) Create(ctx context.Cont
 }
 
 func (rs *globalaccountRoleCollectionAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan globalaccountRoleCollectionAssignmentType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -193,6 +199,8 @@ func (rs *globalaccountRoleCollectionAssignmentResource) Update(ctx context.Cont
 }
 
 func (rs *globalaccountRoleCollectionAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountRoleCollectionAssignmentType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -213,7 +221,7 @@ func (rs *globalaccountRoleCollectionAssignmentResource) Delete(ctx context.Cont
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection Assignment (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection Assignment (Global Account)", errorDetail(err))
 		return
 	}
 }
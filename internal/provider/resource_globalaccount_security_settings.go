@@ -83,6 +83,8 @@ __Further documentation:__
 }
 
 func (rs *globalaccountSecuritySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountSecuritySettingsType
 
 	diags := req.State.Get(ctx, &state)
@@ -106,6 +108,8 @@ func (rs *globalaccountSecuritySettingsResource) Read(ctx context.Context, req r
 }
 
 func (rs *globalaccountSecuritySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan globalaccountSecuritySettingsType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -127,7 +131,7 @@ func (rs *globalaccountSecuritySettingsResource) Create(ctx context.Context, req
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Security Settings (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Security Settings (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -139,6 +143,8 @@ func (rs *globalaccountSecuritySettingsResource) Create(ctx context.Context, req
 }
 
 func (rs *globalaccountSecuritySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan globalaccountSecuritySettingsType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -160,7 +166,7 @@ func (rs *globalaccountSecuritySettingsResource) Update(ctx context.Context, req
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Security Settings (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Security Settings (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -172,6 +178,8 @@ func (rs *globalaccountSecuritySettingsResource) Update(ctx context.Context, req
 }
 
 func (rs *globalaccountSecuritySettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountSecuritySettingsType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -187,7 +195,7 @@ func (rs *globalaccountSecuritySettingsResource) Delete(ctx context.Context, req
 		RefreshTokenValidity:              -1,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Security Settings (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Security Settings (Global Account)", errorDetail(err))
 		return
 	}
 }
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+)
+
+func TestResourceGlobalaccount(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_globalaccount")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					// the existing global account is adopted
+					Config: hclProviderFor(user) + hclResourceGlobalaccount("uut"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("btp_globalaccount.uut", "id", regexpValidUUID),
+						resource.TestCheckResourceAttrSet("btp_globalaccount.uut", "name"),
+						resource.TestCheckResourceAttrSet("btp_globalaccount.uut", "subdomain"),
+						resource.TestCheckResourceAttrSet("btp_globalaccount.uut", "commercial_model"),
+						resource.TestCheckResourceAttrSet("btp_globalaccount.uut", "license_type"),
+						resource.TestCheckResourceAttr("btp_globalaccount.uut", "state", "OK"),
+						resource.TestMatchResourceAttr("btp_globalaccount.uut", "created_date", regexpValidRFC3999Format),
+						resource.TestMatchResourceAttr("btp_globalaccount.uut", "last_modified", regexpValidRFC3999Format),
+					),
+				},
+				{
+					Config: hclProviderFor(user) + hclResourceGlobalaccountWithDescription("uut", "Global account managed by Terraform", "Testing"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("btp_globalaccount.uut", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("btp_globalaccount.uut", "id", regexpValidUUID),
+						resource.TestCheckResourceAttr("btp_globalaccount.uut", "description", "Global account managed by Terraform"),
+						resource.TestCheckResourceAttr("btp_globalaccount.uut", "usage", "Testing"),
+					),
+				},
+				{
+					ResourceName:      "btp_globalaccount.uut",
+					ImportStateIdFunc: getGlobalaccountImportStateId("btp_globalaccount.uut"),
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					// the global account is only removed from the state, so it can still be read afterwards
+					Config: hclProviderFor(user) + hclDatasourceGlobalAccount("ga"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("data.btp_globalaccount.ga", "id", regexpValidUUID),
+						resource.TestCheckResourceAttr("data.btp_globalaccount.ga", "description", "Global account managed by Terraform"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - import with wrong id", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_globalaccount.import_error")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceGlobalaccount("uut"),
+				},
+				{
+					ResourceName:      "btp_globalaccount.uut",
+					ImportStateId:     "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f",
+					ImportState:       true,
+					ImportStateVerify: true,
+					ExpectError:       regexp.MustCompile(`Expected the ID of the global account the provider is configured for`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - usage not valid", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceGlobalaccountWithDescription("uut", "My global account", "Staging"),
+					ExpectError: regexp.MustCompile(`Attribute usage value must be one of`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - name must not be empty", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_globalaccount" "uut" {
+	name = ""
+}`,
+					ExpectError: regexp.MustCompile(`Attribute name string length must be between 1 and 255, got: 0`),
+				},
+			},
+		})
+	})
+}
+
+func hclResourceGlobalaccount(resourceName string) string {
+	return fmt.Sprintf(`resource "btp_globalaccount" "%s" {}`, resourceName)
+}
+
+func hclResourceGlobalaccountWithDescription(resourceName string, description string, usage string) string {
+	return fmt.Sprintf(`resource "btp_globalaccount" "%s" {
+        description = "%s"
+        usage       = "%s"
+    }`, resourceName, description, usage)
+}
+
+func getGlobalaccountImportStateId(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return rs.Primary.ID, nil
+	}
+}
+
+func TestGlobalaccountResource_Adopt(t *testing.T) {
+	globalAccountId := "e7a1ab22-2f6d-4b3e-b9a8-5a4cbb7c1c65"
+
+	type command struct {
+		action string
+		params map[string]string
+	}
+
+	newResource := func(t *testing.T, commands *[]command) *globalaccountResource {
+		return &globalaccountResource{cli: newLoggedInClientFacade(t, func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				ParamValues map[string]string `json:"paramValues"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			*commands = append(*commands, command{action: r.URL.RawQuery, params: body.ParamValues})
+
+			description := "My description"
+			if newDescription, ok := body.ParamValues["description"]; ok {
+				description = newDescription
+			}
+
+			fmt.Fprintf(w, `{"guid":"%s","displayName":"My Global Account","description":"%s","useFor":"Testing","entityState":"OK","subdomain":"my-globalaccount"}`, globalAccountId, description)
+		})}
+	}
+	actionsOf := func(commands []command) []string {
+		actions := []string{}
+		for _, c := range commands {
+			actions = append(actions, c.action)
+		}
+		return actions
+	}
+	plannedGlobalaccount := func(t *testing.T, uut *globalaccountResource, description attr.Value) tfsdk.Plan {
+		state := newTestState(t, uut, map[string]attr.Value{
+			"id":          types.StringUnknown(),
+			"name":        types.StringUnknown(),
+			"description": description,
+			"usage":       types.StringUnknown(),
+		})
+		return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+	}
+	create := func(t *testing.T, uut *globalaccountResource, plan tfsdk.Plan) (*fwresource.CreateResponse, globalaccountResourceType) {
+		resp := &fwresource.CreateResponse{State: newTestState(t, uut, nil)}
+		uut.Create(context.TODO(), fwresource.CreateRequest{Plan: plan}, resp)
+
+		var state globalaccountResourceType
+		resp.State.Get(context.TODO(), &state)
+		return resp, state
+	}
+
+	t.Run("happy path - the global account is adopted without an update", func(t *testing.T) {
+		var commands []command
+		uut := newResource(t, &commands)
+
+		resp, state := create(t, uut, plannedGlobalaccount(t, uut, types.StringValue("My description")))
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, []string{string(btpcli.ActionGet)}, actionsOf(commands))
+		assert.Equal(t, globalAccountId, state.ID.ValueString())
+		assert.Equal(t, "My Global Account", state.Name.ValueString())
+		assert.Equal(t, "Testing", state.Usage.ValueString())
+	})
+	t.Run("happy path - a deviating configuration is applied when adopted", func(t *testing.T) {
+		var commands []command
+		uut := newResource(t, &commands)
+
+		resp, state := create(t, uut, plannedGlobalaccount(t, uut, types.StringValue("Managed by Terraform")))
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, []string{string(btpcli.ActionGet), string(btpcli.ActionUpdate)}, actionsOf(commands))
+		assert.Equal(t, "Managed by Terraform", commands[1].params["description"])
+		assert.NotContains(t, commands[1].params, "displayName")
+		assert.NotContains(t, commands[1].params, "useFor")
+		assert.Equal(t, "Managed by Terraform", state.Description.ValueString())
+	})
+	t.Run("happy path - the global account is not deleted", func(t *testing.T) {
+		var commands []command
+		uut := newResource(t, &commands)
+
+		state := newTestState(t, uut, map[string]attr.Value{
+			"id":   types.StringValue(globalAccountId),
+			"name": types.StringValue("My Global Account"),
+		})
+		resp := &fwresource.DeleteResponse{State: state}
+		uut.Delete(context.TODO(), fwresource.DeleteRequest{State: state}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Empty(t, commands)
+	})
+	t.Run("error path - another global account is imported", func(t *testing.T) {
+		var commands []command
+		uut := newResource(t, &commands)
+
+		resp := &fwresource.ImportStateResponse{State: newTestState(t, uut, nil)}
+		uut.ImportState(context.TODO(), fwresource.ImportStateRequest{ID: "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"}, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Unexpected Import Identifier", resp.Diagnostics.Errors()[0].Summary())
+	})
+}
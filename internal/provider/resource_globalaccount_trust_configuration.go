@@ -138,6 +138,8 @@ __Further documentation:__
 }
 
 func (rs *globalaccountTrustConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountTrustConfigurationType
 
 	diags := req.State.Get(ctx, &state)
@@ -161,6 +163,8 @@ func (rs *globalaccountTrustConfigurationResource) Read(ctx context.Context, req
 }
 
 func (rs *globalaccountTrustConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan globalaccountTrustConfigurationType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -194,13 +198,13 @@ func (rs *globalaccountTrustConfigurationResource) Create(ctx context.Context, r
 
 	createRes, _, err := rs.cli.Security.Trust.CreateByGlobalAccount(ctx, cliCreateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Trust Configuration (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Trust Configuration (Global Account)", errorDetail(err))
 		return
 	}
 
 	getRes, _, err := rs.cli.Security.Trust.GetByGlobalAccount(ctx, createRes.OriginKey)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Trust Configuration after Creation (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Trust Configuration after Creation (Global Account)", errorDetail(err))
 		return
 	}
 
@@ -212,6 +216,8 @@ func (rs *globalaccountTrustConfigurationResource) Create(ctx context.Context, r
 }
 
 func (rs *globalaccountTrustConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan globalaccountTrustConfigurationType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -240,13 +246,13 @@ func (rs *globalaccountTrustConfigurationResource) Update(ctx context.Context, r
 
 	updateRes, _, err := rs.cli.Security.Trust.UpdateByGlobalAccount(ctx, cliUpdateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Trust Configuration (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Trust Configuration (Global Account)", errorDetail(err))
 		return
 	}
 
 	getRes, _, err := rs.cli.Security.Trust.GetByGlobalAccount(ctx, updateRes.OriginKey)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Trust Configuration after Update (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Trust Configuration after Update (Global Account)", errorDetail(err))
 		return
 	}
 	state, diags := globalaccountTrustConfigurationFromValue(ctx, getRes)
@@ -257,6 +263,8 @@ func (rs *globalaccountTrustConfigurationResource) Update(ctx context.Context, r
 }
 
 func (rs *globalaccountTrustConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state globalaccountTrustConfigurationType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -266,7 +274,7 @@ func (rs *globalaccountTrustConfigurationResource) Delete(ctx context.Context, r
 
 	_, _, err := rs.cli.Security.Trust.DeleteByGlobalAccount(ctx, state.Origin.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Trust Configuration (Global Account)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Trust Configuration (Global Account)", errorDetail(err))
 		return
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"maps"
 	"net/http"
 	"regexp"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -32,6 +33,11 @@ type subaccountResource struct {
 	cli *btpcli.ClientFacade
 }
 
+type subaccountResourceType struct {
+	subaccountType
+	Admins types.Set `tfsdk:"admins"`
+}
+
 func (rs *subaccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = fmt.Sprintf("%s_subaccount", req.ProviderTypeName)
 }
@@ -94,14 +100,13 @@ __Further documentation:__
 				},
 			},
 			"parent_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the subaccount’s parent entity. If the subaccount is located directly in the global account (not in a directory), then this is the ID of the global account.",
+				MarkdownDescription: "The ID of the subaccount’s parent entity. If the subaccount is located directly in the global account (not in a directory), then this is the ID of the global account. A change moves the subaccount to the new parent.",
 				Computed:            true,
 				Optional:            true,
 				Validators: []validator.String{
 					uuidvalidator.ValidUUID(),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
@@ -109,7 +114,7 @@ __Further documentation:__
 				ElementType: types.SetType{
 					ElemType: types.StringType,
 				},
-				MarkdownDescription: "The set of words or phrases assigned to the subaccount.",
+				MarkdownDescription: "The set of words or phrases assigned to the subaccount. Removing the attribute from the configuration removes all labels of the subaccount. To manage the labels with the `btp_subaccount_labels` resource instead, add `labels` to the `ignore_changes` of the `lifecycle` block of the subaccount.",
 				Optional:            true,
 			},
 			"beta_enabled": schema.BoolAttribute{
@@ -173,6 +178,11 @@ __Further documentation:__
 					getFormattedValueAsTableRow("`SUSPENSION_FAILED`", "The suspension operations failed."),
 				Computed: true,
 			},
+			"admins": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses of the users who are assigned as administrators of the subaccount. They are assigned to the role collection 'Subaccount Administrator'. Users who are not managed by this attribute keep their assignments. An empty set unassigns all admins which have been assigned by this attribute. Removing the attribute from the configuration stops managing the admins without unassigning them. The admins are always users of the default identity provider `sap.default`. To assign administrators of a custom identity provider, use the `btp_subaccount_role_collection_assignment` resource with the `origin` of the identity provider instead.",
+				Optional:            true,
+			},
 			"usage": schema.StringAttribute{
 				MarkdownDescription: "Shows whether the subaccount is used for production purposes. This flag can help your cloud operator to take appropriate action when handling incidents that are related to mission-critical accounts in production systems. Do not apply for subaccounts that are used for nonproduction purposes, such as development, testing, and demos. Applying this setting this does not modify the subaccount. Possible values are: \n" +
 					getFormattedValueAsTableRow("value", "description") +
@@ -198,7 +208,9 @@ __Further documentation:__
 }
 
 func (rs *subaccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data subaccountType
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var data subaccountResourceType
 
 	diags := req.State.Get(ctx, &data)
 
@@ -213,7 +225,8 @@ func (rs *subaccountResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	data, diags = subaccountValueFrom(ctx, cliRes)
+	// the admins are not returned by the API, so they are kept as they are
+	data.subaccountType, diags = subaccountValueFrom(ctx, cliRes)
 	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &data)
@@ -221,7 +234,9 @@ func (rs *subaccountResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (rs *subaccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan subaccountType
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan subaccountResourceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -256,21 +271,27 @@ func (rs *subaccountResource) Create(ctx context.Context, req resource.CreateReq
 
 	args.UsedForProduction = mapUsageToUsedForProduction(plan.Usage.ValueString())
 
+	if !plan.Admins.IsNull() && !plan.Admins.IsUnknown() && len(plan.Admins.Elements()) > 0 {
+		var admins []string
+		plan.Admins.ElementsAs(ctx, &admins, false)
+		args.SubaccountAdmins = admins
+	}
+
 	cliRes, _, err := rs.cli.Accounts.Subaccount.Create(ctx, &args)
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Subaccount", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Subaccount", errorDetail(err))
 		return
 	}
 
-	plan, diags = subaccountValueFrom(ctx, cliRes)
+	plan.subaccountType, diags = subaccountValueFrom(ctx, cliRes)
 	resp.Diagnostics.Append(diags...)
 
 	createStateConf := &tfutils.StateChangeConf{
 		Pending: []string{cis.StateCreating, cis.StateStarted},
 		Target:  []string{cis.StateOK, cis.StateCreationFailed, cis.StateCanceled},
 		Refresh: func() (interface{}, string, error) {
-			subRes, _, err := rs.cli.Accounts.Subaccount.Get(ctx, cliRes.Guid)
+			subRes, _, err := rs.cli.Accounts.Subaccount.Get(btpcli.WithoutReadCache(ctx), cliRes.Guid)
 
 			if err != nil {
 				return subRes, "", err
@@ -287,10 +308,10 @@ func (rs *subaccountResource) Create(ctx context.Context, req resource.CreateReq
 
 	if err != nil {
 		updatedRes = cliRes
-		resp.Diagnostics.AddError("API Error Creating Resource Subaccount", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Subaccount", errorDetail(err))
 	}
 
-	plan, diags = subaccountValueFrom(ctx, updatedRes.(cis.SubaccountResponseObject))
+	plan.subaccountType, diags = subaccountValueFrom(ctx, updatedRes.(cis.SubaccountResponseObject))
 	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &plan)
@@ -298,7 +319,9 @@ func (rs *subaccountResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (rs *subaccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan subaccountType
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan, state subaccountResourceType
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -307,6 +330,27 @@ func (rs *subaccountResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, unlock := lockSubaccount(ctx, rs.cli, state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
+	if !plan.ParentID.IsUnknown() && plan.ParentID.ValueString() != state.ParentID.ValueString() {
+		err := rs.moveSubaccount(ctx, plan.ID.ValueString(), plan.ParentID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Moving Resource Subaccount", errorDetail(err))
+			return
+		}
+	}
+
 	args := btpcli.SubaccountUpdateInput{
 		BetaEnabled:  plan.BetaEnabled.ValueBool(),
 		Description:  plan.Description.ValueString(),
@@ -324,18 +368,18 @@ func (rs *subaccountResource) Update(ctx context.Context, req resource.UpdateReq
 
 	cliRes, _, err := rs.cli.Accounts.Subaccount.Update(ctx, &args)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Subaccount", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Subaccount", errorDetail(err))
 		return
 	}
 
-	plan, diags = subaccountValueFrom(ctx, cliRes)
+	plan.subaccountType, diags = subaccountValueFrom(ctx, cliRes)
 	resp.Diagnostics.Append(diags...)
 
 	updateStateConf := &tfutils.StateChangeConf{
 		Pending: []string{cis.StateUpdating, cis.StateStarted},
 		Target:  []string{cis.StateOK, cis.StateUpdateFailed, cis.StateCanceled},
 		Refresh: func() (interface{}, string, error) {
-			subRes, _, err := rs.cli.Accounts.Subaccount.Get(ctx, cliRes.Guid)
+			subRes, _, err := rs.cli.Accounts.Subaccount.Get(btpcli.WithoutReadCache(ctx), cliRes.Guid)
 
 			if err != nil {
 				return subRes, "", err
@@ -350,24 +394,118 @@ func (rs *subaccountResource) Update(ctx context.Context, req resource.UpdateReq
 
 	updatedRes, err := updateStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Subaccount", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Subaccount", errorDetail(err))
 	}
 
-	plan, diags = subaccountValueFrom(ctx, updatedRes.(cis.SubaccountResponseObject))
+	plan.subaccountType, diags = subaccountValueFrom(ctx, updatedRes.(cis.SubaccountResponseObject))
 	resp.Diagnostics.Append(diags...)
 
+	plan.Admins, err = rs.reconcileAdmins(ctx, plan.ID.ValueString(), state.Admins, plan.Admins)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Subaccount", errorDetail(err))
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
+// moveSubaccount moves the subaccount to the given parent, i.e. a directory or the global account, and waits until the move has finished
+func (rs *subaccountResource) moveSubaccount(ctx context.Context, subaccountId string, parentId string) error {
+	globalAccount, _, err := rs.cli.Accounts.GlobalAccount.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	directoryId := parentId
+	if parentId == globalAccount.Guid {
+		directoryId = ""
+	}
+
+	_, _, err = rs.cli.Accounts.Subaccount.Move(ctx, subaccountId, directoryId)
+	if err != nil {
+		return err
+	}
+
+	moveStateConf := &tfutils.StateChangeConf{
+		Pending: []string{cis.StateMoving, cis.StateStarted},
+		Target:  []string{cis.StateOK, cis.StateMoveFailed, cis.StateCanceled},
+		Refresh: func() (interface{}, string, error) {
+			subRes, _, err := rs.cli.Accounts.Subaccount.Get(btpcli.WithoutReadCache(ctx), subaccountId)
+
+			if err != nil {
+				return subRes, "", err
+			}
+
+			return subRes, subRes.State, nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	movedRes, err := moveStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if subaccount := movedRes.(cis.SubaccountResponseObject); subaccount.State != cis.StateOK {
+		return fmt.Errorf("the subaccount could not be moved, it is in state %s: %s", subaccount.State, subaccount.StateMessage)
+	}
+
+	return nil
+}
+
+// reconcileAdmins assigns the added admins to and unassigns the removed admins from the role collection 'Subaccount Administrator'.
+// It returns the admins which are actually assigned, so that a partially applied change is reflected in the state.
+// The admins are users of the default identity provider, as the create command does not support another origin either.
+func (rs *subaccountResource) reconcileAdmins(ctx context.Context, subaccountId string, stateAdmins types.Set, planAdmins types.Set) (types.Set, error) {
+	if planAdmins.IsNull() || planAdmins.IsUnknown() || planAdmins.Equal(stateAdmins) {
+		return planAdmins, nil
+	}
+
+	toBeAssigned, toBeUnassigned := diffAdmins(ctx, stateAdmins, planAdmins)
+
+	var assigned []string
+	stateAdmins.ElementsAs(ctx, &assigned, false)
+
+	appliedAdmins := func() types.Set {
+		admins, _ := types.SetValueFrom(ctx, types.StringType, assigned)
+		return admins
+	}
+
+	for _, admin := range toBeAssigned {
+		if _, _, err := rs.cli.Security.RoleCollection.AssignUserBySubaccount(ctx, subaccountId, subaccountAdminRoleCollection, admin, OriginSapDefault); err != nil {
+			return appliedAdmins(), err
+		}
+		assigned = append(assigned, admin)
+	}
+
+	for _, admin := range toBeUnassigned {
+		if _, _, err := rs.cli.Security.RoleCollection.UnassignUserBySubaccount(ctx, subaccountId, subaccountAdminRoleCollection, admin, OriginSapDefault); err != nil {
+			return appliedAdmins(), err
+		}
+		assigned = slices.DeleteFunc(assigned, func(assignedAdmin string) bool { return assignedAdmin == admin })
+	}
+
+	return planAdmins, nil
+}
+
 func (rs *subaccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state subaccountType
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountResourceType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	parentId, isParentGlobalAccount := determineParentIdForAuthorization(rs.cli, ctx, state.ParentID.ValueString())
 
 	var directoryId string
@@ -379,7 +517,7 @@ func (rs *subaccountResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	cliRes, _, err := rs.cli.Accounts.Subaccount.Delete(ctx, state.ID.ValueString(), directoryId)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Subaccount", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Subaccount", errorDetail(err))
 		return
 	}
 
@@ -387,7 +525,7 @@ func (rs *subaccountResource) Delete(ctx context.Context, req resource.DeleteReq
 		Pending: []string{cis.StateDeleting, cis.StateStarted},
 		Target:  []string{cis.StateOK, cis.StateDeletionFailed, cis.StateCanceled, "DELETED"},
 		Refresh: func() (interface{}, string, error) {
-			subRes, comRes, err := rs.cli.Accounts.Subaccount.Get(ctx, cliRes.Guid)
+			subRes, comRes, err := rs.cli.Accounts.Subaccount.Get(btpcli.WithoutReadCache(ctx), cliRes.Guid)
 
 			if comRes.StatusCode == http.StatusNotFound {
 				return subRes, "DELETED", nil
@@ -407,7 +545,7 @@ func (rs *subaccountResource) Delete(ctx context.Context, req resource.DeleteReq
 	_, err = deleteStateConf.WaitForStateContext(ctx)
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Subaccount", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Subaccount", errorDetail(err))
 		return
 	}
 }
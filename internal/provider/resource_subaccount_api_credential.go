@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newSubaccountApiCredentialResource() resource.Resource {
+	return &subaccountApiCredentialResource{}
+}
+
+type subaccountApiCredentialResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *subaccountApiCredentialResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_subaccount_api_credential", req.ProviderTypeName)
+}
+
+func (rs *subaccountApiCredentialResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *subaccountApiCredentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Creates OAuth client credentials to access the APIs of a subaccount, e.g. for automation. The credentials are based on a client secret, or on a client certificate if ` + "`certificate_passed`" + ` is set.
+
+__Tip:__
+The client secret and the private key are only returned when the API credential is created, so they are not available after an import.`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the API credential.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_passed": schema.StringAttribute{
+				MarkdownDescription: "The client certificate in PEM format for a certificate based API credential. If not set, the API credential is based on a client secret.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether the API credential only grants read access. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the OAuth client.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The secret of the OAuth client. Only set for secret based API credentials.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_received": schema.StringAttribute{
+				MarkdownDescription: "The client certificate returned for a certificate based API credential.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The private key of the client certificate, if it has been generated.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the authorization server to get a token.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the APIs to access with the obtained token.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"credential_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the API credential. Possible values are: " +
+					getFormattedValueAsTableRow("type", "description") +
+					getFormattedValueAsTableRow("---", "---") +
+					getFormattedValueAsTableRow("`binding-secret`", "The API credential is based on a client secret.") +
+					getFormattedValueAsTableRow("`x509`", "The API credential is based on a client certificate."),
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (rs *subaccountApiCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountApiCredentialType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, rawRes, err := rs.cli.Security.ApiCredential.GetBySubaccount(ctx, state.SubaccountId.ValueString(), state.Name.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, resp, err, "Resource API Credential (Subaccount)")
+		return
+	}
+
+	newState := subaccountApiCredentialValueFrom(state.SubaccountId.ValueString(), state, cliRes)
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountApiCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan subaccountApiCredentialType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, _, err := rs.cli.Security.ApiCredential.CreateBySubaccount(ctx, btpcli.SubaccountApiCredentialCreateInput{
+		Subaccount:  plan.SubaccountId.ValueString(),
+		Name:        plan.Name.ValueString(),
+		Certificate: plan.CertificatePassed.ValueString(),
+		ReadOnly:    plan.ReadOnly.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource API Credential (Subaccount)", errorDetail(err))
+		return
+	}
+
+	// Only the secrets of the chosen variant are returned
+	plan.ClientSecret = types.StringNull()
+	plan.CertificateReceived = types.StringNull()
+	plan.Key = types.StringNull()
+
+	state := subaccountApiCredentialValueFrom(plan.SubaccountId.ValueString(), plan, cliRes)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountApiCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	// All configurable attributes require a replacement of the API credential
+	resp.Diagnostics.AddError("API Error Updating Resource API Credential (Subaccount)", "This resource is not supposed to be updated")
+}
+
+func (rs *subaccountApiCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountApiCredentialType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := rs.cli.Security.ApiCredential.DeleteBySubaccount(ctx, state.SubaccountId.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource API Credential (Subaccount)", errorDetail(err))
+		return
+	}
+}
+
+func (rs *subaccountApiCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: subaccount_id,name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+}
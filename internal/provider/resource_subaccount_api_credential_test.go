@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testApiCredentialCertificate is a self-signed certificate, which is only used to create API credentials in the tests
+const testApiCredentialCertificate = `-----BEGIN CERTIFICATE-----
+MIIBlzCCAT2gAwIBAgIUfBuf1ih+4yKmdx4Vb0+e78vNCRkwCgYIKoZIzj0EAwIw
+ITEfMB0GA1UEAwwWdGYtdGVzdC1hcGktY3JlZGVudGlhbDAeFw0yNjEwMTcyMzI0
+MDZaFw0zNjEwMTQyMzI0MDZaMCExHzAdBgNVBAMMFnRmLXRlc3QtYXBpLWNyZWRl
+bnRpYWwwWTATBgcqhkjOPQIBBggqhkjOPQMBBwNCAAT8vnhrxeYkwMU4bDntSqjd
+LWW2/6eJZiBDfGXCCGFLq2xUh2eMMlVS+opP5mAah920z2jOWMQD+TvLPBmWBjym
+o1MwUTAdBgNVHQ4EFgQUCWosKYNtAMwgN1lKivK8/rMbbncwHwYDVR0jBBgwFoAU
+CWosKYNtAMwgN1lKivK8/rMbbncwDwYDVR0TAQH/BAUwAwEB/zAKBggqhkjOPQQD
+AgNIADBFAiBRgt03SWnXUbRtgbL4D/gnNfzluj8HkNCgoA26OwhnSAIhAKsZGyJZ
+fD5eVIjW+EabhaKbvJzZkal8iHit90LDdKlF
+-----END CERTIFICATE-----`
+
+func TestResourceSubaccountApiCredential(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - client secret", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_api_credential.secret")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountApiCredential("uut", "integration-test-services-static", "tf-test-api-credential", false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("btp_subaccount_api_credential.uut", "subaccount_id", regexpValidUUID),
+						resource.TestCheckResourceAttr("btp_subaccount_api_credential.uut", "name", "tf-test-api-credential"),
+						resource.TestCheckResourceAttr("btp_subaccount_api_credential.uut", "read_only", "false"),
+						resource.TestCheckResourceAttr("btp_subaccount_api_credential.uut", "credential_type", "binding-secret"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_api_credential.uut", "client_id"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_api_credential.uut", "client_secret"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_api_credential.uut", "token_url"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_api_credential.uut", "api_url"),
+						resource.TestCheckNoResourceAttr("btp_subaccount_api_credential.uut", "certificate_passed"),
+					),
+				},
+				{
+					ResourceName:                         "btp_subaccount_api_credential.uut",
+					ImportStateIdFunc:                    getApiCredentialImportStateId("btp_subaccount_api_credential.uut"),
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "name",
+					// the secrets are only returned by the API when the credential is created
+					ImportStateVerifyIgnore: []string{"client_secret", "key", "certificate_received"},
+				},
+				{
+					// the read-only flag can't be updated, so the credential is replaced
+					Config: hclProviderFor(user) + hclResourceSubaccountApiCredential("uut", "integration-test-services-static", "tf-test-api-credential", true),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("btp_subaccount_api_credential.uut", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_api_credential.uut", "read_only", "true"),
+						resource.TestCheckResourceAttr("btp_subaccount_api_credential.uut", "credential_type", "binding-secret"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_api_credential.uut", "client_secret"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - certificate", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_api_credential.certificate")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountApiCredentialWithCertificate("uut", "integration-test-services-static", "tf-test-api-credential-cert", testApiCredentialCertificate),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("btp_subaccount_api_credential.uut", "subaccount_id", regexpValidUUID),
+						resource.TestCheckResourceAttr("btp_subaccount_api_credential.uut", "name", "tf-test-api-credential-cert"),
+						resource.TestCheckResourceAttr("btp_subaccount_api_credential.uut", "credential_type", "x509"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_api_credential.uut", "client_id"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_api_credential.uut", "certificate_passed"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_api_credential.uut", "token_url"),
+						resource.TestCheckNoResourceAttr("btp_subaccount_api_credential.uut", "client_secret"),
+					),
+				},
+				{
+					ResourceName:                         "btp_subaccount_api_credential.uut",
+					ImportStateIdFunc:                    getApiCredentialImportStateId("btp_subaccount_api_credential.uut"),
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "name",
+					// the passed certificate is only known from the configuration
+					ImportStateVerifyIgnore: []string{"certificate_passed", "certificate_received", "key"},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - import with wrong key", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_api_credential.import_error")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountApiCredential("uut", "integration-test-services-static", "tf-test-api-credential", false),
+				},
+				{
+					ResourceName:                         "btp_subaccount_api_credential.uut",
+					ImportStateId:                        "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "name",
+					ExpectError:                          regexp.MustCompile(`Expected import identifier with format: subaccount_id,name. Got:`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - subaccount_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_subaccount_api_credential" "uut" {
+	subaccount_id = "this-is-not-a-uuid"
+	name          = "tf-test-api-credential"
+}`,
+					ExpectError: regexp.MustCompile(`Attribute subaccount_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - name must not be empty", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_subaccount_api_credential" "uut" {
+	subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	name          = ""
+}`,
+					ExpectError: regexp.MustCompile(`Attribute name string length must be at least 1, got: 0`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - certificate must not be empty", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_subaccount_api_credential" "uut" {
+	subaccount_id      = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	name               = "tf-test-api-credential"
+	certificate_passed = ""
+}`,
+					ExpectError: regexp.MustCompile(`Attribute certificate_passed string length must be at least 1, got: 0`),
+				},
+			},
+		})
+	})
+}
+
+func hclResourceSubaccountApiCredential(resourceName string, subaccountName string, name string, readOnly bool) string {
+	return fmt.Sprintf(`
+		data "btp_subaccounts" "all" {}
+		resource "btp_subaccount_api_credential" "%[1]s" {
+			subaccount_id = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+			name          = "%[3]s"
+			read_only     = %[4]t
+		}`, resourceName, subaccountName, name, readOnly)
+}
+
+func hclResourceSubaccountApiCredentialWithCertificate(resourceName string, subaccountName string, name string, certificate string) string {
+	return fmt.Sprintf(`
+		data "btp_subaccounts" "all" {}
+		resource "btp_subaccount_api_credential" "%[1]s" {
+			subaccount_id      = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+			name               = "%[3]s"
+			certificate_passed = <<-EOT
+%[4]s
+EOT
+		}`, resourceName, subaccountName, name, certificate)
+}
+
+func getApiCredentialImportStateId(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["subaccount_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
@@ -145,6 +145,8 @@ __Further documentation:__
 }
 
 func (rs *subaccountEntitlementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountEntitlementType
 
 	diags := req.State.Get(ctx, &state)
@@ -180,10 +182,14 @@ func (rs *subaccountEntitlementResource) Read(ctx context.Context, req resource.
 }
 
 func (rs *subaccountEntitlementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	rs.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, "Creating")
 }
 
 func (rs *subaccountEntitlementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	rs.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, "Updating")
 }
 
@@ -195,6 +201,12 @@ func (rs *subaccountEntitlementResource) createOrUpdate(ctx context.Context, req
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, plan.SubaccountId.ValueString(), responseDiagnostics)
+	if responseDiagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	// Determine the parent of the subaccount
 	subaccountData, _, _ := rs.cli.Accounts.Subaccount.Get(ctx, plan.SubaccountId.ValueString())
 	//Determine if the parent of the subaccount is a directory and if it has authoization enabled
@@ -242,7 +254,7 @@ func (rs *subaccountEntitlementResource) createOrUpdate(ctx context.Context, req
 	_, err := RetryApiCallConf.WaitForStateContext(ctx)
 
 	if err != nil {
-		responseDiagnostics.AddError(fmt.Sprintf("API Error %s Resource Entitlement (Subaccount)", action), fmt.Sprintf("%s", err))
+		responseDiagnostics.AddError(fmt.Sprintf("API Error %s Resource Entitlement (Subaccount)", action), errorDetail(err))
 		return
 	}
 
@@ -255,7 +267,7 @@ func (rs *subaccountEntitlementResource) createOrUpdate(ctx context.Context, req
 		Target:  []string{cis_entitlements.StateOK},
 		Refresh: func() (interface{}, string, error) {
 
-			entitlement, _, err := rs.cli.Accounts.Entitlement.GetAssignedBySubaccount(ctx, plan.SubaccountId.ValueString(), plan.ServiceName.ValueString(), plan.PlanName.ValueString(), isParentGlobalAccount, parentId)
+			entitlement, _, err := rs.cli.Accounts.Entitlement.GetAssignedBySubaccount(btpcli.WithoutReadCache(ctx), plan.SubaccountId.ValueString(), plan.ServiceName.ValueString(), plan.PlanName.ValueString(), isParentGlobalAccount, parentId)
 
 			if err != nil {
 				return nil, "", err
@@ -278,7 +290,7 @@ func (rs *subaccountEntitlementResource) createOrUpdate(ctx context.Context, req
 
 	entitlement, err := createStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		responseDiagnostics.AddError(fmt.Sprintf("API Error %s Resource Entitlement (Subaccount)", action), fmt.Sprintf("%s", err))
+		responseDiagnostics.AddError(fmt.Sprintf("API Error %s Resource Entitlement (Subaccount)", action), errorDetail(err))
 		return
 	}
 
@@ -291,6 +303,8 @@ func (rs *subaccountEntitlementResource) createOrUpdate(ctx context.Context, req
 }
 
 func (rs *subaccountEntitlementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountEntitlementType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -298,6 +312,12 @@ func (rs *subaccountEntitlementResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, state.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	// Determine the parent of the subaccount
 	subaccountData, _, _ := rs.cli.Accounts.Subaccount.Get(ctx, state.SubaccountId.ValueString())
 	//Determine if the parent of the subaccount is a directory and if it has authoization enabled
@@ -345,7 +365,7 @@ func (rs *subaccountEntitlementResource) Delete(ctx context.Context, req resourc
 	_, err := RetryApiCallConf.WaitForStateContext(ctx)
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Entitlement (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Entitlement (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -357,7 +377,7 @@ func (rs *subaccountEntitlementResource) Delete(ctx context.Context, req resourc
 		Target:  []string{"DELETED"},
 		Refresh: func() (interface{}, string, error) {
 
-			entitlement, _, err := rs.cli.Accounts.Entitlement.GetAssignedBySubaccount(ctx, state.SubaccountId.ValueString(), state.ServiceName.ValueString(), state.PlanName.ValueString(), isParentGlobalAccount, parentId)
+			entitlement, _, err := rs.cli.Accounts.Entitlement.GetAssignedBySubaccount(btpcli.WithoutReadCache(ctx), state.SubaccountId.ValueString(), state.ServiceName.ValueString(), state.PlanName.ValueString(), isParentGlobalAccount, parentId)
 
 			if reflect.ValueOf(entitlement).IsNil() {
 				return entitlement, "DELETED", nil
@@ -382,7 +402,7 @@ func (rs *subaccountEntitlementResource) Delete(ctx context.Context, req resourc
 	_, err = deleteStateConf.WaitForStateContext(ctx)
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Entitlement (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Entitlement (Subaccount)", errorDetail(err))
 		return
 	}
 }
@@ -426,7 +446,8 @@ func isRetriableError(err error) bool {
 		return false
 	}
 
-	if strings.Contains(err.Error(), "[Error: 30004/400]") {
+	var backendErr *btpcli.BackendError
+	if errors.As(err, &backendErr) && strings.Contains(backendErr.Message, "[Error: 30004/400]") {
 		// Error code for a locking scenario - API call must be retried
 		return true
 	} else {
@@ -195,6 +195,8 @@ __Further documentation:__
 }
 
 func (rs *subaccountEnvironmentInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountEnvironmentInstanceType
 
 	diags := req.State.Get(ctx, &state)
@@ -232,6 +234,8 @@ func (rs *subaccountEnvironmentInstanceResource) Read(ctx context.Context, req r
 }
 
 func (rs *subaccountEnvironmentInstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountEnvironmentInstanceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -239,6 +243,12 @@ func (rs *subaccountEnvironmentInstanceResource) Create(ctx context.Context, req
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, plan.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	parameters := plan.Parameters.ValueString()
 
 	cliRes, _, err := rs.cli.Accounts.EnvironmentInstance.Create(ctx, &btpcli.SubaccountEnvironmentInstanceCreateInput{
@@ -251,7 +261,7 @@ func (rs *subaccountEnvironmentInstanceResource) Create(ctx context.Context, req
 		Parameters:      parameters,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Environment Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Environment Instance (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -268,7 +278,7 @@ func (rs *subaccountEnvironmentInstanceResource) Create(ctx context.Context, req
 		Pending: []string{provisioning.StateCreating},
 		Target:  []string{provisioning.StateOK, provisioning.StateCreationFailed},
 		Refresh: func() (interface{}, string, error) {
-			subRes, _, err := rs.cli.Accounts.EnvironmentInstance.Get(ctx, plan.SubaccountId.ValueString(), cliRes.Id)
+			subRes, _, err := rs.cli.Accounts.EnvironmentInstance.Get(btpcli.WithoutReadCache(ctx), plan.SubaccountId.ValueString(), cliRes.Id)
 
 			if err != nil {
 				return subRes, "", err
@@ -283,7 +293,7 @@ func (rs *subaccountEnvironmentInstanceResource) Create(ctx context.Context, req
 
 	updatedRes, err := createStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Environment Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Environment Instance (Subaccount)", errorDetail(err))
 	}
 
 	plan, diags = subaccountEnvironmentInstanceValueFrom(ctx, updatedRes.(provisioning.EnvironmentInstanceResponseObject))
@@ -296,6 +306,8 @@ func (rs *subaccountEnvironmentInstanceResource) Create(ctx context.Context, req
 }
 
 func (rs *subaccountEnvironmentInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountEnvironmentInstanceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -303,6 +315,12 @@ func (rs *subaccountEnvironmentInstanceResource) Update(ctx context.Context, req
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, plan.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	_, _, err := rs.cli.Accounts.EnvironmentInstance.Update(ctx, &btpcli.SubaccountEnvironmentInstanceUpdateInput{
 		EnvironmentID: plan.Id.ValueString(),
 		Parameters:    plan.Parameters.ValueString(),
@@ -310,7 +328,7 @@ func (rs *subaccountEnvironmentInstanceResource) Update(ctx context.Context, req
 		SubaccountID:  plan.SubaccountId.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Environment Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Environment Instance (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -323,7 +341,7 @@ func (rs *subaccountEnvironmentInstanceResource) Update(ctx context.Context, req
 		Pending: []string{provisioning.StateUpdating},
 		Target:  []string{provisioning.StateOK, provisioning.StateUpdateFailed},
 		Refresh: func() (interface{}, string, error) {
-			subRes, _, err := rs.cli.Accounts.EnvironmentInstance.Get(ctx, plan.SubaccountId.ValueString(), plan.Id.ValueString())
+			subRes, _, err := rs.cli.Accounts.EnvironmentInstance.Get(btpcli.WithoutReadCache(ctx), plan.SubaccountId.ValueString(), plan.Id.ValueString())
 
 			if err != nil {
 				return subRes, "", err
@@ -338,7 +356,7 @@ func (rs *subaccountEnvironmentInstanceResource) Update(ctx context.Context, req
 
 	updatedRes, err := updateStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Environment Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Environment Instance (Subaccount)", errorDetail(err))
 	}
 
 	state, diags := subaccountEnvironmentInstanceValueFrom(ctx, updatedRes.(provisioning.EnvironmentInstanceResponseObject))
@@ -352,6 +370,8 @@ func (rs *subaccountEnvironmentInstanceResource) Update(ctx context.Context, req
 }
 
 func (rs *subaccountEnvironmentInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountEnvironmentInstanceType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -359,9 +379,15 @@ func (rs *subaccountEnvironmentInstanceResource) Delete(ctx context.Context, req
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, state.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	cliRes, _, err := rs.cli.Accounts.EnvironmentInstance.Delete(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Environment Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Environment Instance (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -373,7 +399,7 @@ func (rs *subaccountEnvironmentInstanceResource) Delete(ctx context.Context, req
 		Pending: []string{provisioning.StateDeleting},
 		Target:  []string{"DELETED", provisioning.StateDeletionFailed},
 		Refresh: func() (interface{}, string, error) {
-			subRes, comRes, err := rs.cli.Accounts.EnvironmentInstance.Get(ctx, state.SubaccountId.ValueString(), cliRes.Id)
+			subRes, comRes, err := rs.cli.Accounts.EnvironmentInstance.Get(btpcli.WithoutReadCache(ctx), state.SubaccountId.ValueString(), cliRes.Id)
 
 			if comRes.StatusCode == http.StatusNotFound {
 				return subRes, "DELETED", nil
@@ -393,7 +419,7 @@ func (rs *subaccountEnvironmentInstanceResource) Delete(ctx context.Context, req
 	_, err = deleteStateConf.WaitForStateContext(ctx)
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Environment Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Environment Instance (Subaccount)", errorDetail(err))
 		return
 	}
 }
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newSubaccountLabelsResource() resource.Resource {
+	return &subaccountLabelsResource{}
+}
+
+type subaccountLabelsResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *subaccountLabelsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_subaccount_labels", req.ProviderTypeName)
+}
+
+func (rs *subaccountLabelsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *subaccountLabelsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages the user-defined labels of a subaccount independently of the subaccount itself.
+
+By default, only the label keys configured in this resource are managed and all other labels of the subaccount are kept. If ` + "`authoritative`" + ` is set, the configured labels replace all labels of the subaccount.
+
+__Tips:__
+* You must be assigned to the global account admin role, or the directory admin if the subaccount is in a directory that manages its authorizations.
+* If the subaccount is managed by the ` + "`btp_subaccount`" + ` resource, add ` + "`labels`" + ` to the ` + "`ignore_changes`" + ` of its ` + "`lifecycle`" + ` block. Otherwise, the ` + "`btp_subaccount`" + ` resource removes the labels of this resource on its next update.
+
+__Further documentation:__
+<https://help.sap.com/docs/btp/sap-business-technology-platform/account-model>`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{ // required by hashicorps terraform plugin testing framework
+				MarkdownDescription: "The ID of the subaccount.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.SetType{
+					ElemType: types.StringType,
+				},
+				MarkdownDescription: "The labels which are managed by this resource. Each key has up to 10 corresponding values.",
+				Required:            true,
+			},
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: "If true, the configured labels replace all labels of the subaccount, and labels which are added elsewhere are removed. If false, only the configured label keys are managed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (rs *subaccountLabelsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountLabelsType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, rawRes, err := rs.cli.Accounts.Label.ListBySubaccount(ctx, state.SubaccountId.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, resp, err, "Resource Labels (Subaccount)")
+		return
+	}
+
+	// an imported resource has no labels yet and adopts all labels of the subaccount
+	labels := cliRes.Labels
+	if !state.Labels.IsNull() {
+		labels = managedLabels(cliRes.Labels, labelKeys(state.Labels), state.Authoritative.ValueBool())
+	}
+
+	state.Id = state.SubaccountId
+	state.Labels, diags = types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, labels)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountLabelsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan subaccountLabelsType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := rs.applyLabels(ctx, plan.SubaccountId.ValueString(), nil, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Labels (Subaccount)", errorDetail(err))
+		return
+	}
+
+	plan.Id = plan.SubaccountId
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountLabelsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan, state subaccountLabelsType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := rs.applyLabels(ctx, plan.SubaccountId.ValueString(), labelKeys(state.Labels), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Labels (Subaccount)", errorDetail(err))
+		return
+	}
+
+	plan.Id = plan.SubaccountId
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountLabelsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountLabelsType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// removing the resource is the same as managing no labels at all
+	managedKeys := labelKeys(state.Labels)
+	state.Labels = types.MapNull(types.SetType{ElemType: types.StringType})
+
+	err := rs.applyLabels(ctx, state.SubaccountId.ValueString(), managedKeys, state)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Labels (Subaccount)", errorDetail(err))
+		return
+	}
+}
+
+func (rs *subaccountLabelsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), false)...)
+}
+
+// applyLabels updates the labels of the subaccount so that the planned labels are set and the previously managed ones, which are no longer planned, are removed
+func (rs *subaccountLabelsResource) applyLabels(ctx context.Context, subaccountId string, managedKeys []string, plan subaccountLabelsType) error {
+	current, _, err := rs.cli.Accounts.Label.ListBySubaccount(btpcli.WithoutReadCache(ctx), subaccountId)
+	if err != nil {
+		return err
+	}
+
+	var planned map[string][]string
+	plan.Labels.ElementsAs(ctx, &planned, false)
+
+	_, _, err = rs.cli.Accounts.Label.UpdateBySubaccount(ctx, subaccountId, labelsToApply(current.Labels, managedKeys, planned, plan.Authoritative.ValueBool()))
+	return err
+}
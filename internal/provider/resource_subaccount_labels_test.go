@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestResourceSubaccountLabels(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - labels of a subaccount which does not manage its labels", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_labels.with_subaccount")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) +
+						hclResourceSubaccountWithIgnoredLabels("uut", "integration-test-labels", "eu12", "integration-test-labels") +
+						hclResourceSubaccountLabels("uut", "btp_subaccount.uut.id", "platform"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPair("btp_subaccount_labels.uut", "subaccount_id", "btp_subaccount.uut", "id"),
+						resource.TestCheckResourceAttr("btp_subaccount_labels.uut", "labels.%", "1"),
+						resource.TestCheckResourceAttr("btp_subaccount_labels.uut", "labels.team.0", "platform"),
+						resource.TestCheckResourceAttr("btp_subaccount_labels.uut", "authoritative", "false"),
+					),
+				},
+				{
+					// an update of the subaccount must not remove the labels managed by the labels resource
+					Config: hclProviderFor(user) +
+						hclResourceSubaccountWithIgnoredLabels("uut", "Integration Test Labels", "eu12", "integration-test-labels") +
+						hclResourceSubaccountLabels("uut", "btp_subaccount.uut.id", "platform"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount.uut", "name", "Integration Test Labels"),
+						resource.TestCheckResourceAttr("btp_subaccount.uut", "labels.team.0", "platform"),
+						resource.TestCheckResourceAttr("btp_subaccount_labels.uut", "labels.team.0", "platform"),
+					),
+				},
+				{
+					Config: hclProviderFor(user) +
+						hclResourceSubaccountWithIgnoredLabels("uut", "Integration Test Labels", "eu12", "integration-test-labels") +
+						hclResourceSubaccountLabels("uut", "btp_subaccount.uut.id", "security"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_labels.uut", "labels.team.0", "security"),
+					),
+				},
+				{
+					ResourceName:      "btp_subaccount_labels.uut",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
+
+func hclResourceSubaccountLabels(resourceName string, subaccountIdReference string, team string) string {
+	template := `
+resource "btp_subaccount_labels" "%s" {
+    subaccount_id = %s
+    labels = {
+        "team" = ["%s"]
+    }
+}`
+	return fmt.Sprintf(template, resourceName, subaccountIdReference, team)
+}
+
+func hclResourceSubaccountWithIgnoredLabels(resourceName string, displayName string, region string, subdomain string) string {
+	template := `
+resource "btp_subaccount" "%s" {
+    name      = "%s"
+    region    = "%s"
+    subdomain = "%s"
+    lifecycle {
+        ignore_changes = [labels]
+    }
+}`
+
+	return fmt.Sprintf(template, resourceName, displayName, region, subdomain)
+}
@@ -85,6 +85,8 @@ __Further documentation:__
 }
 
 func (rs *subaccountRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountRoleType
 
 	diags := req.State.Get(ctx, &state)
@@ -101,7 +103,7 @@ func (rs *subaccountRoleResource) Read(ctx context.Context, req resource.ReadReq
 		state.RoleTemplateName.ValueString(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -120,6 +122,8 @@ func (rs *subaccountRoleResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (rs *subaccountRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountRoleType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -134,7 +138,7 @@ func (rs *subaccountRoleResource) Create(ctx context.Context, req resource.Creat
 		SubaccountId:     plan.SubaccountId.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Role (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Role (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -151,6 +155,8 @@ func (rs *subaccountRoleResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (rs *subaccountRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountRoleType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -165,6 +171,8 @@ func (rs *subaccountRoleResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (rs *subaccountRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountRoleType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -174,7 +182,7 @@ func (rs *subaccountRoleResource) Delete(ctx context.Context, req resource.Delet
 
 	_, _, err := rs.cli.Security.Role.DeleteBySubaccount(ctx, state.SubaccountId.ValueString(), state.Name.ValueString(), state.RoleTemplateAppId.ValueString(), state.RoleTemplateName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Role (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Role (Subaccount)", errorDetail(err))
 		return
 	}
 }
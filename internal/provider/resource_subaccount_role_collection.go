@@ -123,6 +123,8 @@ __Further documentation:__
 }
 
 func (rs *subaccountRoleCollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountRoleCollectionType
 
 	diags := req.State.Get(ctx, &state)
@@ -160,6 +162,8 @@ func (rs *subaccountRoleCollectionResource) Read(ctx context.Context, req resour
 }
 
 func (rs *subaccountRoleCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountRoleCollectionType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -167,9 +171,15 @@ func (rs *subaccountRoleCollectionResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, plan.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	cliRes, _, err := rs.cli.Security.RoleCollection.CreateBySubaccount(ctx, plan.SubaccountId.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Role Collection (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Role Collection (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -177,7 +187,7 @@ func (rs *subaccountRoleCollectionResource) Create(ctx context.Context, req reso
 		_, err := rs.cli.Security.Role.AddBySubaccount(ctx, plan.SubaccountId.ValueString(), plan.Name.ValueString(), role.Name.ValueString(), role.RoleTemplateAppId.ValueString(), role.RoleTemplateName.ValueString())
 
 		if err != nil {
-			resp.Diagnostics.AddError("API Error Adding Role To Role Collection (Subaccount)", fmt.Sprintf("%s", err))
+			resp.Diagnostics.AddError("API Error Adding Role To Role Collection (Subaccount)", errorDetail(err))
 		}
 	}
 
@@ -191,6 +201,8 @@ func (rs *subaccountRoleCollectionResource) Create(ctx context.Context, req reso
 }
 
 func (rs *subaccountRoleCollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountRoleCollectionType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -204,9 +216,15 @@ func (rs *subaccountRoleCollectionResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, plan.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	_, _, err := rs.cli.Security.RoleCollection.UpdateBySubaccount(ctx, plan.SubaccountId.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Role Collection (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Role Collection (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -215,7 +233,7 @@ func (rs *subaccountRoleCollectionResource) Update(ctx context.Context, req reso
 		_, err := rs.cli.Security.Role.RemoveBySubaccount(ctx, plan.SubaccountId.ValueString(), plan.Name.ValueString(), role.Name.ValueString(), role.RoleTemplateAppId.ValueString(), role.RoleTemplateName.ValueString())
 
 		if err != nil {
-			resp.Diagnostics.AddError("API Error Removing Role From Role Collection (Subaccount)", fmt.Sprintf("%s", err))
+			resp.Diagnostics.AddError("API Error Removing Role From Role Collection (Subaccount)", errorDetail(err))
 		}
 	}
 
@@ -224,13 +242,13 @@ func (rs *subaccountRoleCollectionResource) Update(ctx context.Context, req reso
 		_, err := rs.cli.Security.Role.AddBySubaccount(ctx, plan.SubaccountId.ValueString(), plan.Name.ValueString(), role.Name.ValueString(), role.RoleTemplateAppId.ValueString(), role.RoleTemplateName.ValueString())
 
 		if err != nil {
-			resp.Diagnostics.AddError("API Error Adding Role From Role Collection (Subaccount)", fmt.Sprintf("%s", err))
+			resp.Diagnostics.AddError("API Error Adding Role From Role Collection (Subaccount)", errorDetail(err))
 		}
 	}
 
 	cliRes, _, err := rs.cli.Security.RoleCollection.GetBySubaccount(ctx, plan.SubaccountId.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role Collection (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Reading Resource Role Collection (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -252,6 +270,8 @@ func (rs *subaccountRoleCollectionResource) Update(ctx context.Context, req reso
 }
 
 func (rs *subaccountRoleCollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountRoleCollectionType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -259,9 +279,15 @@ func (rs *subaccountRoleCollectionResource) Delete(ctx context.Context, req reso
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, state.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	_, _, err := rs.cli.Security.RoleCollection.DeleteBySubaccount(ctx, state.SubaccountId.ValueString(), state.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection (Subaccount)", errorDetail(err))
 		return
 	}
 }
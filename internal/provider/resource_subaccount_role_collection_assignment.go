@@ -140,6 +140,8 @@ func (rs *subaccountRoleCollectionAssignmentResource) Schema(_ context.Context,
 }
 
 func (rs *subaccountRoleCollectionAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountRoleCollectionAssignmentType
 
 	diags := req.State.Get(ctx, &state)
@@ -155,6 +157,8 @@ func (rs *subaccountRoleCollectionAssignmentResource) Read(ctx context.Context,
 }
 
 func (rs *subaccountRoleCollectionAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountRoleCollectionAssignmentType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -175,7 +179,7 @@ func (rs *subaccountRoleCollectionAssignmentResource) Create(ctx context.Context
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Role Collection Assignment (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Role Collection Assignment (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -187,6 +191,8 @@ func (rs *subaccountRoleCollectionAssignmentResource) Create(ctx context.Context
 }
 
 func (rs *subaccountRoleCollectionAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountRoleCollectionAssignmentType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -205,6 +211,8 @@ func (rs *subaccountRoleCollectionAssignmentResource) Update(ctx context.Context
 }
 
 func (rs *subaccountRoleCollectionAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountRoleCollectionAssignmentType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -225,7 +233,7 @@ func (rs *subaccountRoleCollectionAssignmentResource) Delete(ctx context.Context
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection Assignment (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection Assignment (Subaccount)", errorDetail(err))
 		return
 	}
 }
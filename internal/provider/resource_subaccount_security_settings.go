@@ -92,6 +92,8 @@ __Further documentation:__
 }
 
 func (rs *subaccountSecuritySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountSecuritySettingsType
 
 	diags := req.State.Get(ctx, &state)
@@ -117,6 +119,8 @@ func (rs *subaccountSecuritySettingsResource) Read(ctx context.Context, req reso
 }
 
 func (rs *subaccountSecuritySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountSecuritySettingsType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -138,7 +142,7 @@ func (rs *subaccountSecuritySettingsResource) Create(ctx context.Context, req re
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Security Settings (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Security Settings (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -151,6 +155,8 @@ func (rs *subaccountSecuritySettingsResource) Create(ctx context.Context, req re
 }
 
 func (rs *subaccountSecuritySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountSecuritySettingsType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -177,7 +183,7 @@ func (rs *subaccountSecuritySettingsResource) Update(ctx context.Context, req re
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Security Settings (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Security Settings (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -190,6 +196,8 @@ func (rs *subaccountSecuritySettingsResource) Update(ctx context.Context, req re
 }
 
 func (rs *subaccountSecuritySettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountSecuritySettingsType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -206,7 +214,7 @@ func (rs *subaccountSecuritySettingsResource) Delete(ctx context.Context, req re
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Security Settings (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Security Settings (Subaccount)", errorDetail(err))
 		return
 	}
 }
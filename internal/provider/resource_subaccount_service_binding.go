@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/SAP/terraform-provider-btp/internal/btpcli"
 	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
 	"github.com/SAP/terraform-provider-btp/internal/tfutils"
+	"github.com/SAP/terraform-provider-btp/internal/validation/durationvalidator"
 	"github.com/SAP/terraform-provider-btp/internal/validation/jsonvalidator"
 	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
 )
@@ -28,6 +30,9 @@ func newSubaccountServiceBindingResource() resource.Resource {
 	return &subaccountServiceBindingResource{}
 }
 
+// defaultBindingRotationOverlap is the time the previous binding is kept after a rotation, if not configured otherwise
+const defaultBindingRotationOverlap = 24 * time.Hour
+
 type subaccountServiceBindingResource struct {
 	cli *btpcli.ClientFacade
 }
@@ -46,7 +51,10 @@ func (rs *subaccountServiceBindingResource) Configure(_ context.Context, req res
 
 func (rs *subaccountServiceBindingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: `Creates a service binding, i.e. generates access details to consume a service.`,
+		MarkdownDescription: `Creates a service binding, i.e. generates access details to consume a service.
+
+__Credential rotation:__
+If ` + "`rotation_period`" + ` or ` + "`rotation_trigger`" + ` is set, the credentials are rotated without downtime: a new binding is created and the previous one is kept for the ` + "`rotation_overlap`" + `, so that consumers can switch to the new credentials. Previous bindings whose overlap has passed are deleted by the next apply.`,
 		Attributes: map[string]schema.Attribute{
 			"subaccount_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the subaccount.",
@@ -54,14 +62,23 @@ func (rs *subaccountServiceBindingResource) Schema(_ context.Context, _ resource
 				Validators: []validator.String{
 					uuidvalidator.ValidUUID(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"service_instance_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the service instance associated with the binding.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the service binding.",
+				MarkdownDescription: "The name of the service binding. Rotated bindings get the time of their creation as suffix, as the names of the bindings of a service instance must be unique.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"parameters": schema.StringAttribute{
 				MarkdownDescription: "The parameters of the service binding as a valid JSON object.",
@@ -109,6 +126,76 @@ func (rs *subaccountServiceBindingResource) Schema(_ context.Context, _ resource
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"credentials_map": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The decoded credentials to access the binding. Nested objects and arrays are provided as JSON strings.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"oauth_credentials": schema.SingleNestedAttribute{
+				MarkdownDescription: "The OAuth client of the binding, if its credentials contain one, e.g. for bindings of the SAP Authorization and Trust Management service.",
+				Attributes: map[string]schema.Attribute{
+					"clientid": schema.StringAttribute{
+						MarkdownDescription: "A public identifier of the app.",
+						Computed:            true,
+					},
+					"clientsecret": schema.StringAttribute{
+						MarkdownDescription: "Secret known only to the app and the authorization server.",
+						Computed:            true,
+						Sensitive:           true,
+					},
+					"url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the authorization server to get a token.",
+						Computed:            true,
+					},
+					"xsappname": schema.StringAttribute{
+						MarkdownDescription: "The name of the xsapp used to get the access token.",
+						Computed:            true,
+					},
+				},
+				Computed:  true,
+				Sensitive: true,
+			},
+			"rotation_period": schema.StringAttribute{
+				MarkdownDescription: "The period after which the binding is rotated, e.g. `720h`. The rotation takes place with the first apply after the period has passed.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationvalidator.ValidDuration(),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value which rotates the binding whenever it changes.",
+				Optional:            true,
+			},
+			"rotation_overlap": schema.StringAttribute{
+				MarkdownDescription: "The time for which the previous binding is kept after a rotation, e.g. `48h`. Defaults to `24h`.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationvalidator.ValidDuration(),
+				},
+			},
+			"previous_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the previous service binding, which is kept for the rotation overlap.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_credentials": schema.StringAttribute{
+				MarkdownDescription: "The credentials of the previous service binding, which are valid until the previous binding is deleted.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_expiration_date": schema.StringAttribute{
+				MarkdownDescription: "The date and time in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format after which the previous service binding is deleted by the next apply.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"state": schema.StringAttribute{
 				MarkdownDescription: "The current state of the service binding. Possible values are: \n" +
 					getFormattedValueAsTableRow("state", "description") +
@@ -131,7 +218,9 @@ func (rs *subaccountServiceBindingResource) Schema(_ context.Context, _ resource
 }
 
 func (rs *subaccountServiceBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state subaccountServiceBindingType
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountServiceBindingResourceType
 
 	diags := req.State.Get(ctx, &state)
 
@@ -146,7 +235,12 @@ func (rs *subaccountServiceBindingResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	updatedState, diags := subaccountServiceBindingValueFrom(ctx, cliRes)
+	updatedState, diags := subaccountServiceBindingResourceValueFrom(ctx, cliRes, state)
+
+	// Rotated bindings carry a suffix in their name, so the configured name is kept, even if the rotation has been disabled since
+	if isRotatedBindingName(state.Name.ValueString(), updatedState.Name.ValueString()) {
+		updatedState.Name = state.Name
+	}
 
 	if updatedState.Parameters.IsNull() && !state.Parameters.IsNull() {
 		// The parameters are not returned by the API so we transfer the existing state to the read result if not existing
@@ -163,41 +257,218 @@ func (rs *subaccountServiceBindingResource) Read(ctx context.Context, req resour
 }
 
 func (rs *subaccountServiceBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan subaccountServiceBindingType
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan subaccountServiceBindingResourceType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, unlock := lockSubaccount(ctx, rs.cli, plan.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
+	cliRes, err := rs.createBinding(ctx, plan, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Service Binding (Subaccount)", errorDetail(err))
+		return
+	}
+
+	updatedPlan, diags := subaccountServiceBindingResourceValueFrom(ctx, cliRes, plan)
+	updatedPlan.Parameters = plan.Parameters
+	updatedPlan.PreviousId = types.StringNull()
+	updatedPlan.PreviousCredentials = types.StringNull()
+	updatedPlan.PreviousExpirationDate = types.StringNull()
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &updatedPlan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan plans the rotation of the binding and the deletion of the previous binding once its overlap has passed
+func (rs *subaccountServiceBindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate if the resource gets created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state subaccountServiceBindingResourceType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+
+	if isBindingRotationDue(plan, state, now) {
+		plan.Id = types.StringUnknown()
+		plan.Ready = types.BoolUnknown()
+		plan.Context = types.StringUnknown()
+		plan.BindResource = types.MapUnknown(types.StringType)
+		plan.Credentials = types.StringUnknown()
+		plan.CredentialsMap = types.MapUnknown(types.StringType)
+		plan.OAuthCredentials = types.ObjectUnknown(serviceBindingOAuthCredentialsType)
+		plan.State = types.StringUnknown()
+		plan.CreatedDate = types.StringUnknown()
+		plan.LastModified = types.StringUnknown()
+		plan.PreviousId = types.StringUnknown()
+		plan.PreviousCredentials = types.StringUnknown()
+		plan.PreviousExpirationDate = types.StringUnknown()
+	} else if expirationDate, err := time.Parse(time.RFC3339, state.PreviousExpirationDate.ValueString()); err == nil && !now.Before(expirationDate) {
+		plan.PreviousId = types.StringNull()
+		plan.PreviousCredentials = types.StringNull()
+		plan.PreviousExpirationDate = types.StringNull()
+	} else {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (rs *subaccountServiceBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan, state subaccountServiceBindingResourceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, unlock := lockSubaccount(ctx, rs.cli, plan.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
+	if !plan.Labels.IsUnknown() && !plan.Labels.Equal(state.Labels) {
+		resp.Diagnostics.AddError("API Error Updating Resource Service Binding (Subaccount)", "The labels of a service binding are not supposed to be updated")
+		return
+	}
+
+	var updatedState subaccountServiceBindingResourceType
+
+	if plan.Id.IsUnknown() {
+		// The new binding is created first, so that the current credentials stay valid if the creation fails
+		cliRes, err := rs.createBinding(ctx, plan, fmt.Sprintf("%s-%d", plan.Name.ValueString(), time.Now().Unix()))
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Rotating Resource Service Binding (Subaccount)", errorDetail(err))
+			return
+		}
+
+		// Only one previous binding is kept, so a binding which has not been cleaned up yet is superseded by the rotation
+		if !state.PreviousId.IsNull() {
+			if err := deleteServiceBinding(ctx, rs.cli, state.SubaccountId.ValueString(), state.PreviousId.ValueString()); err != nil {
+				resp.Diagnostics.AddWarning("API Error Deleting Previous Service Binding (Subaccount)", fmt.Sprintf("The binding %s must be deleted manually: %s", state.PreviousId.ValueString(), errorDetail(err)))
+			}
+		}
+
+		overlap := defaultBindingRotationOverlap
+		if !plan.RotationOverlap.IsNull() {
+			overlap, _ = time.ParseDuration(plan.RotationOverlap.ValueString())
+		}
+
+		updatedState, diags = subaccountServiceBindingResourceValueFrom(ctx, cliRes, plan)
+		updatedState.PreviousId = state.Id
+		updatedState.PreviousCredentials = state.Credentials
+		updatedState.PreviousExpirationDate = timeToValue(time.Now().Add(overlap))
+		resp.Diagnostics.Append(diags...)
+	} else {
+		if plan.PreviousId.IsNull() && !state.PreviousId.IsNull() {
+			if err := deleteServiceBinding(ctx, rs.cli, state.SubaccountId.ValueString(), state.PreviousId.ValueString()); err != nil {
+				resp.Diagnostics.AddError("API Error Deleting Previous Service Binding (Subaccount)", errorDetail(err))
+				return
+			}
+		}
+
+		cliRes, _, err := rs.cli.Services.Binding.GetById(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Updating Resource Service Binding (Subaccount)", errorDetail(err))
+			return
+		}
+
+		updatedState, diags = subaccountServiceBindingResourceValueFrom(ctx, cliRes, plan)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	updatedState.Name = plan.Name
+	updatedState.Parameters = plan.Parameters
+
+	diags = resp.State.Set(ctx, &updatedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountServiceBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountServiceBindingResourceType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, unlock := lockSubaccount(ctx, rs.cli, state.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
+	if !state.PreviousId.IsNull() {
+		if err := deleteServiceBinding(ctx, rs.cli, state.SubaccountId.ValueString(), state.PreviousId.ValueString()); err != nil {
+			resp.Diagnostics.AddError("API Error Deleting Previous Service Binding (Subaccount)", errorDetail(err))
+			return
+		}
+	}
+
+	if err := deleteServiceBinding(ctx, rs.cli, state.SubaccountId.ValueString(), state.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Service Binding (Subaccount)", errorDetail(err))
+		return
+	}
+}
+
+// createBinding creates a binding with the given name and waits until it is ready
+func (rs *subaccountServiceBindingResource) createBinding(ctx context.Context, plan subaccountServiceBindingResourceType, name string) (servicemanager.ServiceBindingResponseObject, error) {
 	cliReq := btpcli.SubaccountServiceBindingCreateInput{
 		Subaccount:        plan.SubaccountId.ValueString(),
 		ServiceInstanceId: plan.ServiceInstanceId.ValueString(),
-		Name:              plan.Name.ValueString(),
+		Name:              name,
 		Parameters:        plan.Parameters.ValueString(),
 	}
 
-	if !plan.Labels.IsNull() {
+	if !plan.Labels.IsNull() && !plan.Labels.IsUnknown() {
 		var labels map[string][]string
 		plan.Labels.ElementsAs(ctx, &labels, false)
 
 		cliReq.Labels = labels
 	}
 
-	cliRes, _, err := rs.cli.Services.Binding.Create(ctx, cliReq)
+	return createServiceBinding(ctx, rs.cli, cliReq)
+}
+
+// createServiceBinding creates the requested binding and waits until it is ready
+func createServiceBinding(ctx context.Context, cli *btpcli.ClientFacade, cliReq btpcli.SubaccountServiceBindingCreateInput) (servicemanager.ServiceBindingResponseObject, error) {
+	cliRes, _, err := cli.Services.Binding.Create(ctx, cliReq)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Service Binding (Subaccount)", fmt.Sprintf("%s", err))
-		return
+		return cliRes, err
 	}
 
-	updatedPlan, diags := subaccountServiceBindingValueFrom(ctx, cliRes)
-	resp.Diagnostics.Append(diags...)
-
 	createStateConf := &tfutils.StateChangeConf{
 		Pending: []string{servicemanager.StateInProgress},
 		Target:  []string{servicemanager.StateSucceeded},
 		Refresh: func() (interface{}, string, error) {
-			subRes, _, err := rs.cli.Services.Binding.GetById(ctx, plan.SubaccountId.ValueString(), cliRes.Id)
+			subRes, _, err := cli.Services.Binding.GetById(btpcli.WithoutReadCache(ctx), cliReq.Subaccount, cliRes.Id)
 
 			if err != nil {
 				return subRes, "", err
@@ -217,50 +488,34 @@ func (rs *subaccountServiceBindingResource) Create(ctx context.Context, req reso
 
 	updatedRes, err := createStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Service Binding (Subaccount)", fmt.Sprintf("%s", err))
-	}
+		// the binding is not kept in the state, so it must not be left behind
+		if deleteErr := deleteServiceBinding(ctx, cli, cliReq.Subaccount, cliRes.Id); deleteErr != nil {
+			return cliRes, errors.Join(err, fmt.Errorf("the binding %s could not be deleted: %w", cliRes.Id, deleteErr))
+		}
 
-	updatedPlan, diags = subaccountServiceBindingValueFrom(ctx, updatedRes.(servicemanager.ServiceBindingResponseObject))
-	updatedPlan.Parameters = plan.Parameters
-	resp.Diagnostics.Append(diags...)
-
-	diags = resp.State.Set(ctx, &updatedPlan)
-	resp.Diagnostics.Append(diags...)
-}
-
-func (rs *subaccountServiceBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan subaccountServiceBindingType
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+		return cliRes, err
 	}
 
-	resp.Diagnostics.AddError("API Error Updating Resource Service Binding (Subaccount)", "This resource is not supposed to be updated")
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	return updatedRes.(servicemanager.ServiceBindingResponseObject), nil
 }
 
-func (rs *subaccountServiceBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state subaccountServiceBindingType
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+// deleteServiceBinding deletes the given binding and waits until it is gone. A binding which does not exist anymore is
+// considered to be deleted.
+func deleteServiceBinding(ctx context.Context, cli *btpcli.ClientFacade, subaccountId string, bindingId string) error {
+	_, comRes, err := cli.Services.Binding.Delete(ctx, subaccountId, bindingId)
+	if comRes.StatusCode == http.StatusNotFound {
+		return nil
 	}
 
-	_, _, err := rs.cli.Services.Binding.Delete(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Service Binding (Subaccount)", fmt.Sprintf("%s", err))
-		return
+		return err
 	}
 
 	deleteStateConf := &tfutils.StateChangeConf{
 		Pending: []string{servicemanager.StateInProgress},
 		Target:  []string{"DELETED"},
 		Refresh: func() (interface{}, string, error) {
-			subRes, comRes, err := rs.cli.Services.Binding.GetById(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+			subRes, comRes, err := cli.Services.Binding.GetById(btpcli.WithoutReadCache(ctx), subaccountId, bindingId)
 
 			if comRes.StatusCode == http.StatusNotFound {
 				return subRes, "DELETED", nil
@@ -284,10 +539,41 @@ func (rs *subaccountServiceBindingResource) Delete(ctx context.Context, req reso
 
 	_, err = deleteStateConf.WaitForStateContext(ctx)
 
+	return err
+}
+
+// isRotatedBindingName returns whether the name of a binding is the configured name with the suffix of a rotation
+func isRotatedBindingName(configuredName string, name string) bool {
+	suffix, found := strings.CutPrefix(name, configuredName+"-")
+	if !found || len(suffix) == 0 {
+		return false
+	}
+
+	_, err := strconv.ParseUint(suffix, 10, 64)
+	return err == nil
+}
+
+// isBindingRotationDue returns whether the rotation trigger changed or the rotation period of the current binding has passed
+func isBindingRotationDue(plan subaccountServiceBindingResourceType, state subaccountServiceBindingResourceType, now time.Time) bool {
+	if !plan.RotationTrigger.IsNull() && !plan.RotationTrigger.IsUnknown() && !plan.RotationTrigger.Equal(state.RotationTrigger) {
+		return true
+	}
+
+	if plan.RotationPeriod.IsNull() || plan.RotationPeriod.IsUnknown() {
+		return false
+	}
+
+	period, err := time.ParseDuration(plan.RotationPeriod.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Service Instance (Subaccount)", fmt.Sprintf("%s", err))
-		return
+		return false
 	}
+
+	createdDate, err := time.Parse(time.RFC3339, state.CreatedDate.ValueString())
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(createdDate.Add(period))
 }
 
 func (rs *subaccountServiceBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
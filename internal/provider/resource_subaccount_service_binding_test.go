@@ -1,14 +1,30 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"regexp"
+	"slices"
+	"strings"
+	"sync"
 
 	//"regexp"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
 )
 
 func TestResourceSubaccountServiceBinding(t *testing.T) {
@@ -198,3 +214,304 @@ func getServiceBindingImportStateIdNoServiceBindingId(resourceName string) resou
 		return rs.Primary.Attributes["subaccount_id"], nil
 	}
 }
+
+func TestIsBindingRotationDue(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		plan        subaccountServiceBindingResourceType
+		state       subaccountServiceBindingResourceType
+		expects     bool
+	}{
+		{
+			description: "no rotation configured",
+			plan:        subaccountServiceBindingResourceType{},
+			state:       subaccountServiceBindingResourceType{CreatedDate: types.StringValue("2024-01-01T12:00:00Z")},
+			expects:     false,
+		},
+		{
+			description: "rotation period not passed",
+			plan:        subaccountServiceBindingResourceType{RotationPeriod: types.StringValue("720h")},
+			state:       subaccountServiceBindingResourceType{CreatedDate: types.StringValue("2024-02-15T12:00:00Z")},
+			expects:     false,
+		},
+		{
+			description: "rotation period passed",
+			plan:        subaccountServiceBindingResourceType{RotationPeriod: types.StringValue("720h")},
+			state:       subaccountServiceBindingResourceType{CreatedDate: types.StringValue("2024-01-01T12:00:00Z")},
+			expects:     true,
+		},
+		{
+			description: "rotation trigger unchanged",
+			plan:        subaccountServiceBindingResourceType{RotationTrigger: types.StringValue("1")},
+			state:       subaccountServiceBindingResourceType{RotationTrigger: types.StringValue("1"), CreatedDate: types.StringValue("2024-01-01T12:00:00Z")},
+			expects:     false,
+		},
+		{
+			description: "rotation trigger changed",
+			plan:        subaccountServiceBindingResourceType{RotationTrigger: types.StringValue("2")},
+			state:       subaccountServiceBindingResourceType{RotationTrigger: types.StringValue("1"), CreatedDate: types.StringValue("2024-01-01T12:00:00Z")},
+			expects:     true,
+		},
+		{
+			description: "rotation trigger removed",
+			plan:        subaccountServiceBindingResourceType{RotationTrigger: types.StringNull()},
+			state:       subaccountServiceBindingResourceType{RotationTrigger: types.StringValue("1"), CreatedDate: types.StringValue("2024-01-01T12:00:00Z")},
+			expects:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			assert.Equal(t, test.expects, isBindingRotationDue(test.plan, test.state, now))
+		})
+	}
+}
+
+func TestServiceBindingCredentialsValueFrom(t *testing.T) {
+	t.Run("flat oauth client", func(t *testing.T) {
+		credentialsMap, oauthCredentials, diags := serviceBindingCredentialsValueFrom(context.TODO(), json.RawMessage(`{"clientid":"my-client","clientsecret":"my-secret","url":"https://my.authentication.sap","xsappname":"my-app","verificationkey":{"kty":"RSA"},"tenantmode":"dedicated","port":443}`))
+
+		assert.False(t, diags.HasError())
+
+		var values map[string]string
+		credentialsMap.ElementsAs(context.TODO(), &values, false)
+		assert.Equal(t, map[string]string{
+			"clientid":        "my-client",
+			"clientsecret":    "my-secret",
+			"url":             "https://my.authentication.sap",
+			"xsappname":       "my-app",
+			"verificationkey": `{"kty":"RSA"}`,
+			"tenantmode":      "dedicated",
+			"port":            "443",
+		}, values)
+
+		assert.Equal(t, "my-client", oauthCredentials.Attributes()["clientid"].(types.String).ValueString())
+		assert.Equal(t, "my-secret", oauthCredentials.Attributes()["clientsecret"].(types.String).ValueString())
+		assert.Equal(t, "https://my.authentication.sap", oauthCredentials.Attributes()["url"].(types.String).ValueString())
+		assert.Equal(t, "my-app", oauthCredentials.Attributes()["xsappname"].(types.String).ValueString())
+	})
+	t.Run("nested oauth client", func(t *testing.T) {
+		_, oauthCredentials, diags := serviceBindingCredentialsValueFrom(context.TODO(), json.RawMessage(`{"host":"my.hana.sap","uaa":{"clientid":"my-client","clientsecret":"my-secret","url":"https://my.authentication.sap","xsappname":"my-app"}}`))
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "my-client", oauthCredentials.Attributes()["clientid"].(types.String).ValueString())
+		assert.Equal(t, "https://my.authentication.sap", oauthCredentials.Attributes()["url"].(types.String).ValueString())
+	})
+	t.Run("no oauth client", func(t *testing.T) {
+		credentialsMap, oauthCredentials, diags := serviceBindingCredentialsValueFrom(context.TODO(), json.RawMessage(`{"access_key_id":"my-key","secret_access_key":"my-secret"}`))
+
+		assert.False(t, diags.HasError())
+		assert.Len(t, credentialsMap.Elements(), 2)
+		assert.True(t, oauthCredentials.IsNull())
+	})
+	t.Run("no credentials", func(t *testing.T) {
+		credentialsMap, oauthCredentials, diags := serviceBindingCredentialsValueFrom(context.TODO(), nil)
+
+		assert.False(t, diags.HasError())
+		assert.True(t, credentialsMap.IsNull())
+		assert.True(t, oauthCredentials.IsNull())
+	})
+}
+
+func TestIsRotatedBindingName(t *testing.T) {
+	assert.True(t, isRotatedBindingName("my-binding", "my-binding-1709294400"))
+	assert.False(t, isRotatedBindingName("my-binding", "my-binding"))
+	assert.False(t, isRotatedBindingName("my-binding", "my-binding-"))
+	assert.False(t, isRotatedBindingName("my-binding", "my-binding-blue"))
+	assert.False(t, isRotatedBindingName("my-binding", "other-binding-1709294400"))
+}
+
+// fakeBindingServer serves the service binding commands of the CLI server based on an in-memory set of bindings
+type fakeBindingServer struct {
+	mu           sync.Mutex
+	bindings     map[string]string
+	created      int
+	failCreation bool
+	commands     []string
+}
+
+func newFakeBindingServer(bindings map[string]string) *fakeBindingServer {
+	return &fakeBindingServer{bindings: bindings}
+}
+
+func (srv *fakeBindingServer) handle(w http.ResponseWriter, r *http.Request) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	var body struct {
+		ParamValues map[string]string `json:"paramValues"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	id, action := body.ParamValues["id"], r.URL.RawQuery
+	writeBinding := func(id string, state string) {
+		fmt.Fprintf(w, `{"id":"%s","name":"%s","subaccount_id":"%s","service_instance_id":"%s","ready":true,"last_operation":{"state":"%s"},"credentials":{"clientid":"%s"}}`,
+			id, srv.bindings[id], body.ParamValues["subaccount"], "1c4dc1d7-8af5-4b27-b9d3-8ad4e3d0f0b9", state, id)
+	}
+
+	switch action {
+	case "create":
+		srv.created++
+		id = fmt.Sprintf("binding-new-%d", srv.created)
+		srv.bindings[id] = body.ParamValues["name"]
+		writeBinding(id, servicemanager.StateInProgress)
+	case "get", "delete":
+		if _, found := srv.bindings[id]; !found {
+			w.Header().Set(btpcli.HeaderCLIBackendStatus, "404")
+			fmt.Fprintf(w, `{"error":"binding not found"}`)
+			break
+		}
+
+		if action == "delete" {
+			delete(srv.bindings, id)
+			fmt.Fprintf(w, "{}")
+		} else if srv.failCreation && strings.HasPrefix(id, "binding-new-") {
+			writeBinding(id, servicemanager.StateFailed)
+		} else {
+			writeBinding(id, servicemanager.StateSucceeded)
+		}
+	}
+
+	srv.commands = append(srv.commands, action+" "+id)
+}
+
+// remainingBindings returns the sorted IDs of the bindings which exist on the server
+func (srv *fakeBindingServer) remainingBindings() []string {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	ids := []string{}
+	for id := range srv.bindings {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	return ids
+}
+
+func TestSubaccountServiceBindingResource_Rotation(t *testing.T) {
+	const subaccountId = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+
+	bindingState := func(t *testing.T, uut *subaccountServiceBindingResource, id string, previousId string) tfsdk.State {
+		return newTestState(t, uut, map[string]attr.Value{
+			"subaccount_id":       types.StringValue(subaccountId),
+			"service_instance_id": types.StringValue("1c4dc1d7-8af5-4b27-b9d3-8ad4e3d0f0b9"),
+			"name":                types.StringValue("my-binding"),
+			"parameters":          types.StringValue("{}"),
+			"id":                  types.StringValue(id),
+			"credentials":         types.StringValue(fmt.Sprintf(`{"clientid":"%s"}`, id)),
+			"rotation_trigger":    types.StringValue("1"),
+			"previous_id":         stringNullIfEmpty(previousId),
+		})
+	}
+	plannedRotation := func(t *testing.T, state tfsdk.State) tfsdk.Plan {
+		plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+		assert.False(t, plan.SetAttribute(context.TODO(), path.Root("rotation_trigger"), types.StringValue("2")).HasError())
+		assert.False(t, plan.SetAttribute(context.TODO(), path.Root("id"), types.StringUnknown()).HasError())
+		assert.False(t, plan.SetAttribute(context.TODO(), path.Root("previous_id"), types.StringUnknown()).HasError())
+		return plan
+	}
+
+	t.Run("happy path - the new binding is created before the superseded one is deleted", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newFakeBindingServer(map[string]string{"binding-previous": "my-binding-1", "binding-current": "my-binding-2"})
+		uut := &subaccountServiceBindingResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		state := bindingState(t, uut, "binding-current", "binding-previous")
+		resp := &fwresource.UpdateResponse{State: newTestState(t, uut, nil)}
+		uut.Update(context.TODO(), fwresource.UpdateRequest{State: state, Plan: plannedRotation(t, state)}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, []string{"create binding-new-1", "get binding-new-1", "delete binding-previous", "get binding-previous"}, srv.commands)
+		assert.Equal(t, []string{"binding-current", "binding-new-1"}, srv.remainingBindings())
+
+		var updatedState subaccountServiceBindingResourceType
+		resp.State.Get(context.TODO(), &updatedState)
+		assert.Equal(t, "binding-new-1", updatedState.Id.ValueString())
+		assert.Equal(t, "my-binding", updatedState.Name.ValueString())
+		assert.Equal(t, "binding-current", updatedState.PreviousId.ValueString())
+		assert.Equal(t, `{"clientid":"binding-current"}`, updatedState.PreviousCredentials.ValueString())
+		assert.False(t, updatedState.PreviousExpirationDate.IsNull())
+	})
+	t.Run("happy path - the previous binding is deleted once the overlap has passed", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newFakeBindingServer(map[string]string{"binding-previous": "my-binding-1", "binding-current": "my-binding-2"})
+		uut := &subaccountServiceBindingResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		state := bindingState(t, uut, "binding-current", "binding-previous")
+		plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+		assert.False(t, plan.SetAttribute(context.TODO(), path.Root("previous_id"), types.StringNull()).HasError())
+
+		resp := &fwresource.UpdateResponse{State: newTestState(t, uut, nil)}
+		uut.Update(context.TODO(), fwresource.UpdateRequest{State: state, Plan: plan}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, []string{"binding-current"}, srv.remainingBindings())
+
+		var updatedState subaccountServiceBindingResourceType
+		resp.State.Get(context.TODO(), &updatedState)
+		assert.Equal(t, "binding-current", updatedState.Id.ValueString())
+		assert.Equal(t, "my-binding", updatedState.Name.ValueString())
+		assert.True(t, updatedState.PreviousId.IsNull())
+	})
+	t.Run("happy path - destroy deletes the current and the previous binding", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newFakeBindingServer(map[string]string{"binding-previous": "my-binding-1", "binding-current": "my-binding-2"})
+		uut := &subaccountServiceBindingResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		resp := &fwresource.DeleteResponse{}
+		uut.Delete(context.TODO(), fwresource.DeleteRequest{State: bindingState(t, uut, "binding-current", "binding-previous")}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Empty(t, srv.remainingBindings())
+	})
+	t.Run("happy path - destroy ignores a previous binding which is already gone", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newFakeBindingServer(map[string]string{"binding-current": "my-binding-2"})
+		uut := &subaccountServiceBindingResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		resp := &fwresource.DeleteResponse{}
+		uut.Delete(context.TODO(), fwresource.DeleteRequest{State: bindingState(t, uut, "binding-current", "binding-previous")}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Empty(t, srv.remainingBindings())
+	})
+	t.Run("happy path - the configured name is kept after the rotation has been disabled", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newFakeBindingServer(map[string]string{"binding-current": "my-binding-1709294400"})
+		uut := &subaccountServiceBindingResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		state := bindingState(t, uut, "binding-current", "")
+		assert.False(t, state.SetAttribute(context.TODO(), path.Root("rotation_trigger"), types.StringNull()).HasError())
+
+		resp := &fwresource.ReadResponse{State: state}
+		uut.Read(context.TODO(), fwresource.ReadRequest{State: state}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+		var updatedState subaccountServiceBindingResourceType
+		resp.State.Get(context.TODO(), &updatedState)
+		assert.Equal(t, "my-binding", updatedState.Name.ValueString())
+	})
+	t.Run("error path - a failed rotation deletes the new binding and keeps the current ones", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newFakeBindingServer(map[string]string{"binding-previous": "my-binding-1", "binding-current": "my-binding-2"})
+		srv.failCreation = true
+		uut := &subaccountServiceBindingResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		state := bindingState(t, uut, "binding-current", "binding-previous")
+		resp := &fwresource.UpdateResponse{State: newTestState(t, uut, nil)}
+		uut.Update(context.TODO(), fwresource.UpdateRequest{State: state, Plan: plannedRotation(t, state)}, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, []string{"binding-current", "binding-previous"}, srv.remainingBindings())
+	})
+}
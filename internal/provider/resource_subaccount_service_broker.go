@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newSubaccountServiceBrokerResource() resource.Resource {
+	return &subaccountServiceBrokerResource{}
+}
+
+type subaccountServiceBrokerResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *subaccountServiceBrokerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_subaccount_service_broker", req.ProviderTypeName)
+}
+
+func (rs *subaccountServiceBrokerResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *subaccountServiceBrokerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Registers a service broker in a subaccount.
+
+__Further documentation:__
+<https://help.sap.com/docs/service-manager/sap-service-manager/working-with-service-brokers>`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service broker.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the service broker.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the service broker.",
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username for the basic authentication against the service broker.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password for the basic authentication against the service broker.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.SetType{
+					ElemType: types.StringType,
+				},
+				MarkdownDescription: "The set of words or phrases assigned to the service broker.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service broker.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ready": schema.BoolAttribute{
+				MarkdownDescription: "Shows whether the service broker is ready.",
+				Computed:            true,
+			},
+			"created_date": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was last modified in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (rs *subaccountServiceBrokerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountServiceBrokerType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, rawRes, err := rs.cli.Services.Broker.GetById(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, resp, err, "Resource Service Broker (Subaccount)")
+		return
+	}
+
+	// The credentials are not returned by the API, so they are kept as they are. After an import they remain unset.
+	newState, diags := subaccountServiceBrokerValueFrom(ctx, state.SubaccountId.ValueString(), state, cliRes)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountServiceBrokerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan subaccountServiceBrokerType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliReq := btpcli.ServiceBrokerRegisterInput{
+		Subaccount:  plan.SubaccountId.ValueString(),
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		URL:         plan.Url.ValueString(),
+		User:        plan.Username.ValueString(),
+		Password:    plan.Password.ValueString(),
+	}
+
+	if !plan.Labels.IsNull() && !plan.Labels.IsUnknown() {
+		var labels map[string][]string
+		plan.Labels.ElementsAs(ctx, &labels, false)
+
+		cliReq.Labels = labels
+	}
+
+	cliRes, _, err := rs.cli.Services.Broker.Register(ctx, &cliReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Service Broker (Subaccount)", errorDetail(err))
+		return
+	}
+
+	state, diags := subaccountServiceBrokerValueFrom(ctx, plan.SubaccountId.ValueString(), plan, cliRes)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountServiceBrokerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var stateCurrent, plan subaccountServiceBrokerType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &stateCurrent)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The credentials are always transferred, as the API does not allow to compare them with the current ones
+	cliReq := btpcli.ServiceBrokerUpdateInput{
+		Id:          stateCurrent.Id.ValueString(),
+		Subaccount:  plan.SubaccountId.ValueString(),
+		Description: plan.Description.ValueString(),
+		URL:         plan.Url.ValueString(),
+		User:        plan.Username.ValueString(),
+		Password:    plan.Password.ValueString(),
+	}
+
+	if plan.Name.ValueString() != stateCurrent.Name.ValueString() {
+		cliReq.NewName = plan.Name.ValueString()
+	}
+
+	// Labels of plan and state need to be transferred as a delta must be computed for the update operation
+	if !plan.Labels.IsNull() && !plan.Labels.IsUnknown() {
+		var labelsFromPlan map[string][]string
+		plan.Labels.ElementsAs(ctx, &labelsFromPlan, false)
+
+		cliReq.LabelsPlan = labelsFromPlan
+	}
+
+	if !stateCurrent.Labels.IsNull() {
+		var labelsFromState map[string][]string
+		stateCurrent.Labels.ElementsAs(ctx, &labelsFromState, false)
+
+		cliReq.LabelsState = labelsFromState
+	}
+
+	cliRes, _, err := rs.cli.Services.Broker.Update(ctx, &cliReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Service Broker (Subaccount)", errorDetail(err))
+		return
+	}
+
+	state, diags := subaccountServiceBrokerValueFrom(ctx, plan.SubaccountId.ValueString(), plan, cliRes)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountServiceBrokerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountServiceBrokerType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := rs.cli.Services.Broker.Unregister(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Service Broker (Subaccount)", errorDetail(err))
+		return
+	}
+}
+
+func (rs *subaccountServiceBrokerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: subaccount_id,id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceSubaccountServiceBroker(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_service_broker")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountServiceBroker("uut", "integration-test-services-static", "tf-test-broker", "My service broker", "https://broker.example.com", "broker-user", "broker-password"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("btp_subaccount_service_broker.uut", "id", regexpValidUUID),
+						resource.TestMatchResourceAttr("btp_subaccount_service_broker.uut", "subaccount_id", regexpValidUUID),
+						resource.TestCheckResourceAttr("btp_subaccount_service_broker.uut", "name", "tf-test-broker"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_broker.uut", "description", "My service broker"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_broker.uut", "url", "https://broker.example.com"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_broker.uut", "labels.team.0", "platform"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_broker.uut", "ready", "true"),
+						resource.TestMatchResourceAttr("btp_subaccount_service_broker.uut", "created_date", regexpValidRFC3999Format),
+						resource.TestMatchResourceAttr("btp_subaccount_service_broker.uut", "last_modified", regexpValidRFC3999Format),
+					),
+				},
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountServiceBroker("uut", "integration-test-services-static", "tf-test-broker-renamed", "My updated service broker", "https://broker.example.com/v2", "broker-user", "new-broker-password"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_service_broker.uut", "name", "tf-test-broker-renamed"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_broker.uut", "description", "My updated service broker"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_broker.uut", "url", "https://broker.example.com/v2"),
+					),
+				},
+				{
+					ResourceName:      "btp_subaccount_service_broker.uut",
+					ImportStateIdFunc: getServiceBrokerImportStateId("btp_subaccount_service_broker.uut"),
+					ImportState:       true,
+					ImportStateVerify: true,
+					// the credentials are not returned by the API
+					ImportStateVerifyIgnore: []string{"username", "password"},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - import with wrong key", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_service_broker.import_error")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountServiceBroker("uut", "integration-test-services-static", "tf-test-broker", "My service broker", "https://broker.example.com", "broker-user", "broker-password"),
+				},
+				{
+					ResourceName:      "btp_subaccount_service_broker.uut",
+					ImportStateId:     "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f",
+					ImportState:       true,
+					ImportStateVerify: true,
+					ExpectError:       regexp.MustCompile(`Expected import identifier with format: subaccount_id,id. Got:`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - subaccount_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_subaccount_service_broker" "uut" {
+	subaccount_id = "this-is-not-a-uuid"
+	name          = "tf-test-broker"
+	url           = "https://broker.example.com"
+	username      = "broker-user"
+	password      = "broker-password"
+}`,
+					ExpectError: regexp.MustCompile(`Attribute subaccount_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - url is mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_subaccount_service_broker" "uut" {
+	subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	name          = "tf-test-broker"
+	username      = "broker-user"
+	password      = "broker-password"
+}`,
+					ExpectError: regexp.MustCompile(`The argument "url" is required, but no definition was found`),
+				},
+			},
+		})
+	})
+}
+
+func hclResourceSubaccountServiceBroker(resourceName string, subaccountName string, name string, description string, url string, username string, password string) string {
+	return fmt.Sprintf(`
+		data "btp_subaccounts" "all" {}
+		resource "btp_subaccount_service_broker" "%[1]s" {
+			subaccount_id = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+			name          = "%[3]s"
+			description   = "%[4]s"
+			url           = "%[5]s"
+			username      = "%[6]s"
+			password      = "%[7]s"
+			labels        = {"team" = ["platform"]}
+		}`, resourceName, subaccountName, name, description, url, username, password)
+}
+
+func getServiceBrokerImportStateId(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["subaccount_id"], rs.Primary.ID), nil
+	}
+}
@@ -8,12 +8,16 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli"
@@ -59,8 +63,37 @@ func (rs *subaccountServiceInstanceResource) Schema(ctx context.Context, _ resou
 				Required:            true,
 			},
 			"serviceplan_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the service plan.",
-				Required:            true,
+				MarkdownDescription: "The ID of the service plan. Either the ID of the service plan or the `offering_name` and `plan_name` must be specified.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("plan_name")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"offering_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service offering. Use it together with `plan_name` as an alternative to `serviceplan_id`. If not set, it is determined from the service plan of the instance.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("plan_name")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"plan_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service plan. Use it together with `offering_name` as an alternative to `serviceplan_id`. If not set, it is determined from the service plan of the instance. The names are resolved when they are changed in the configuration, so a service plan which is renamed later on keeps the name it had when it was resolved.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("offering_name")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"labels": schema.MapAttribute{
 				ElementType: types.SetType{
@@ -106,8 +139,12 @@ func (rs *subaccountServiceInstanceResource) Schema(ctx context.Context, _ resou
 				Computed:            true,
 			},
 			"shared": schema.BoolAttribute{
-				MarkdownDescription: "Shows whether the service instance is shared.",
+				MarkdownDescription: "Shows whether the service instance is shared. Set to `true` to share the service instance with the other environments of the subaccount, e.g. Cloud Foundry spaces and Kyma namespaces. The service plan must support instance sharing. Set to `false` to unshare it. If the attribute is not set, the sharing of the service instance is not changed, e.g. for imported instances or instances which are shared outside of Terraform.",
+				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"context": schema.StringAttribute{
 				MarkdownDescription: "Contextual data for the resource.",
@@ -133,7 +170,83 @@ func (rs *subaccountServiceInstanceResource) Schema(ctx context.Context, _ resou
 	}
 }
 
+func (rs *subaccountServiceInstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate if the resource gets destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || rs.cli == nil {
+		return
+	}
+
+	var plan subaccountServiceInstanceType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state subaccountServiceInstanceType
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	var planNameInConfig types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("plan_name"), &planNameInConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case planNameInConfig.IsNull():
+		// The service plan is configured by its ID, so the names are determined again once the ID is changed
+		if !plan.ServicePlanId.Equal(state.ServicePlanId) {
+			plan.OfferingName = types.StringUnknown()
+			plan.PlanName = types.StringUnknown()
+		}
+	case plan.PlanName.IsUnknown() || plan.OfferingName.IsUnknown() || plan.SubaccountId.IsUnknown():
+		// The service plan can only be resolved by its name during the apply
+		plan.ServicePlanId = types.StringUnknown()
+	case req.State.Raw.IsNull() || !state.SubaccountId.Equal(plan.SubaccountId) || !state.OfferingName.Equal(plan.OfferingName) || !state.PlanName.Equal(plan.PlanName):
+		// Resolve the service plan by its name, so that the plan shows the affected service plan. If an existing instance refers to
+		// a plan which can't be found, the ID stays unknown and the update reports the error.
+		servicePlan, _, err := rs.cli.Services.Plan.GetByName(ctx, plan.SubaccountId.ValueString(), plan.PlanName.ValueString(), plan.OfferingName.ValueString())
+		if err == nil {
+			plan.ServicePlanId = types.StringValue(servicePlan.Id)
+		} else if req.State.Raw.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("plan_name"), "API Error Reading Service Plan (Subaccount)", errorDetail(err))
+			return
+		} else {
+			plan.ServicePlanId = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("serviceplan_id"), plan.ServicePlanId)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("offering_name"), plan.OfferingName)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("plan_name"), plan.PlanName)...)
+
+	// Only a configured sharing is applied, so the service plan is only checked if the instance is going to be shared
+	sharedInConfig, diags := configuredSharing(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if !sharedInConfig.ValueBool() || plan.SubaccountId.IsUnknown() || plan.ServicePlanId.IsUnknown() {
+		return
+	}
+
+	servicePlan, _, err := rs.cli.Services.Plan.GetById(ctx, plan.SubaccountId.ValueString(), plan.ServicePlanId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Service Plan (Subaccount)", errorDetail(err))
+		return
+	}
+
+	if servicePlan.Metadata == nil || !servicePlan.Metadata.SupportsInstanceSharing {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("shared"),
+			"Service Instance Sharing Not Supported",
+			fmt.Sprintf("The service plan '%s' does not support the sharing of service instances.", servicePlan.Name),
+		)
+	}
+}
+
 func (rs *subaccountServiceInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountServiceInstanceType
 
 	diags := req.State.Get(ctx, &state)
@@ -162,11 +275,21 @@ func (rs *subaccountServiceInstanceResource) Read(ctx context.Context, req resou
 
 	resp.Diagnostics.Append(diags...)
 
+	// The names of the service plan are only determined if they are not known yet, e.g. after an import, or if the
+	// service plan of the instance was exchanged outside of Terraform
+	if newState.ServicePlanId.Equal(state.ServicePlanId) {
+		rs.setServicePlanNames(ctx, &newState, state.OfferingName, state.PlanName, &resp.Diagnostics)
+	} else {
+		rs.setServicePlanNames(ctx, &newState, types.StringNull(), types.StringNull(), &resp.Diagnostics)
+	}
+
 	diags = resp.State.Set(ctx, &newState)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (rs *subaccountServiceInstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountServiceInstanceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -174,10 +297,23 @@ func (rs *subaccountServiceInstanceResource) Create(ctx context.Context, req res
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, plan.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	cliReq := btpcli.ServiceInstanceCreateInput{
-		Subaccount:    plan.SubaccountId.ValueString(),
-		Name:          plan.Name.ValueString(),
-		ServicePlanId: plan.ServicePlanId.ValueString(),
+		Subaccount: plan.SubaccountId.ValueString(),
+		Name:       plan.Name.ValueString(),
+	}
+
+	// The service plan can only be resolved by its name during the apply, if e.g. the subaccount was unknown during the plan
+	if plan.ServicePlanId.IsUnknown() {
+		cliReq.OfferingName = plan.OfferingName.ValueString()
+		cliReq.PlanName = plan.PlanName.ValueString()
+	} else {
+		cliReq.ServicePlanId = plan.ServicePlanId.ValueString()
 	}
 
 	if !plan.Parameters.IsNull() {
@@ -194,7 +330,7 @@ func (rs *subaccountServiceInstanceResource) Create(ctx context.Context, req res
 
 	cliRes, _, err := rs.cli.Services.Instance.Create(ctx, &cliReq)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Service Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Service Instance (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -211,7 +347,7 @@ func (rs *subaccountServiceInstanceResource) Create(ctx context.Context, req res
 		Pending: []string{servicemanager.StateInProgress},
 		Target:  []string{servicemanager.StateSucceeded},
 		Refresh: func() (interface{}, string, error) {
-			subRes, _, err := rs.cli.Services.Instance.GetById(ctx, state.SubaccountId.ValueString(), cliRes.Id)
+			subRes, _, err := rs.cli.Services.Instance.GetById(btpcli.WithoutReadCache(ctx), state.SubaccountId.ValueString(), cliRes.Id)
 
 			if err != nil {
 				return subRes, "", err
@@ -231,19 +367,31 @@ func (rs *subaccountServiceInstanceResource) Create(ctx context.Context, req res
 
 	updatedRes, err := createStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Service Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Service Instance (Subaccount)", errorDetail(err))
 	}
 
 	state, diags = subaccountServiceInstanceValueFrom(ctx, updatedRes.(servicemanager.ServiceInstanceResponseObject))
 	state.Parameters = plan.Parameters
+	rs.setServicePlanNames(ctx, &state, plan.OfferingName, plan.PlanName, &resp.Diagnostics)
 	state.Timeouts = timeoutsLocal
 	resp.Diagnostics.Append(diags...)
 
+	if sharedInConfig, diags := configuredSharing(ctx, req.Config); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+	} else if !sharedInConfig.IsNull() && sharedInConfig.ValueBool() != state.Shared.ValueBool() {
+		err = rs.updateSharing(ctx, &state, sharedInConfig.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Sharing Resource Service Instance (Subaccount)", errorDetail(err))
+		}
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (rs *subaccountServiceInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var stateCurrent, plan subaccountServiceInstanceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -257,6 +405,22 @@ func (rs *subaccountServiceInstanceResource) Update(ctx context.Context, req res
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, plan.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
+	if plan.ServicePlanId.IsUnknown() && !plan.PlanName.IsNull() {
+		servicePlan, _, err := rs.cli.Services.Plan.GetByName(ctx, plan.SubaccountId.ValueString(), plan.PlanName.ValueString(), plan.OfferingName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Updating Resource Service Instance (Subaccount)", errorDetail(err))
+			return
+		}
+
+		plan.ServicePlanId = types.StringValue(servicePlan.Id)
+	}
+
 	cliReq := btpcli.ServiceInstanceUpdateInput{
 		Subaccount:    plan.SubaccountId.ValueString(),
 		Id:            plan.Id.ValueString(),
@@ -286,7 +450,7 @@ func (rs *subaccountServiceInstanceResource) Update(ctx context.Context, req res
 
 	cliRes, _, err := rs.cli.Services.Instance.Update(ctx, &cliReq)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Service Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Service Instance (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -303,7 +467,7 @@ func (rs *subaccountServiceInstanceResource) Update(ctx context.Context, req res
 		Pending: []string{servicemanager.StateInProgress},
 		Target:  []string{servicemanager.StateSucceeded},
 		Refresh: func() (interface{}, string, error) {
-			subRes, _, err := rs.cli.Services.Instance.GetById(ctx, state.SubaccountId.ValueString(), cliRes.Id)
+			subRes, _, err := rs.cli.Services.Instance.GetById(btpcli.WithoutReadCache(ctx), state.SubaccountId.ValueString(), cliRes.Id)
 
 			if err != nil {
 				return subRes, "", err
@@ -323,19 +487,83 @@ func (rs *subaccountServiceInstanceResource) Update(ctx context.Context, req res
 
 	updatedRes, err := updateStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Service Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Service Instance (Subaccount)", errorDetail(err))
 	}
 
 	state, diags = subaccountServiceInstanceValueFrom(ctx, updatedRes.(servicemanager.ServiceInstanceResponseObject))
 	state.Parameters = plan.Parameters
+	rs.setServicePlanNames(ctx, &state, plan.OfferingName, plan.PlanName, &resp.Diagnostics)
 	state.Timeouts = timeoutsLocal
 	resp.Diagnostics.Append(diags...)
 
+	if sharedInConfig, diags := configuredSharing(ctx, req.Config); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+	} else if !sharedInConfig.IsNull() && sharedInConfig.ValueBool() != state.Shared.ValueBool() {
+		err = rs.updateSharing(ctx, &state, sharedInConfig.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Sharing Resource Service Instance (Subaccount)", errorDetail(err))
+		}
+	}
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
+// setServicePlanNames stores the given names of the service plan in the state. Names which are not known are determined
+// from the service plan of the instance. If this fails, the names are left empty and determined again by the next read.
+func (rs *subaccountServiceInstanceResource) setServicePlanNames(ctx context.Context, state *subaccountServiceInstanceType, offeringName types.String, planName types.String, diags *diag.Diagnostics) {
+	if !offeringName.IsNull() && !offeringName.IsUnknown() && !planName.IsNull() && !planName.IsUnknown() {
+		state.OfferingName = offeringName
+		state.PlanName = planName
+		return
+	}
+
+	state.OfferingName = types.StringNull()
+	state.PlanName = types.StringNull()
+
+	servicePlan, _, err := rs.cli.Services.Plan.GetById(ctx, state.SubaccountId.ValueString(), state.ServicePlanId.ValueString())
+	if err != nil {
+		diags.AddWarning("API Error Reading Service Plan (Subaccount)", fmt.Sprintf("The names of the service plan could not be determined: %s", errorDetail(err)))
+		return
+	}
+
+	serviceOffering, _, err := rs.cli.Services.Offering.GetById(ctx, state.SubaccountId.ValueString(), servicePlan.ServiceOfferingId)
+	if err != nil {
+		diags.AddWarning("API Error Reading Service Offering (Subaccount)", fmt.Sprintf("The names of the service plan could not be determined: %s", errorDetail(err)))
+		return
+	}
+
+	state.OfferingName = types.StringValue(serviceOffering.Name)
+	state.PlanName = types.StringValue(servicePlan.Name)
+}
+
+// configuredSharing returns the sharing of the service instance in the configuration. A null value means that the sharing
+// is not managed, so that instances which are shared outside of Terraform are not unshared.
+func configuredSharing(ctx context.Context, config tfsdk.Config) (types.Bool, diag.Diagnostics) {
+	var shared types.Bool
+	diags := config.GetAttribute(ctx, path.Root("shared"), &shared)
+
+	return shared, diags
+}
+
+// updateSharing shares or unshares the service instance and reflects the result in the given state
+func (rs *subaccountServiceInstanceResource) updateSharing(ctx context.Context, state *subaccountServiceInstanceType, shared bool) (err error) {
+	if shared {
+		_, err = rs.cli.Services.Instance.Share(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	} else {
+		_, err = rs.cli.Services.Instance.Unshare(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	}
+
+	if err == nil {
+		state.Shared = types.BoolValue(shared)
+	}
+
+	return
+}
+
 func (rs *subaccountServiceInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountServiceInstanceType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -343,9 +571,15 @@ func (rs *subaccountServiceInstanceResource) Delete(ctx context.Context, req res
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, state.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	_, err := rs.cli.Services.Instance.Delete(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Service Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Service Instance (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -357,7 +591,7 @@ func (rs *subaccountServiceInstanceResource) Delete(ctx context.Context, req res
 		Pending: []string{servicemanager.StateInProgress},
 		Target:  []string{"DELETED"},
 		Refresh: func() (interface{}, string, error) {
-			subRes, comRes, err := rs.cli.Services.Instance.GetById(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+			subRes, comRes, err := rs.cli.Services.Instance.GetById(btpcli.WithoutReadCache(ctx), state.SubaccountId.ValueString(), state.Id.ValueString())
 
 			if comRes.StatusCode == http.StatusNotFound {
 				return subRes, "DELETED", nil
@@ -382,7 +616,7 @@ func (rs *subaccountServiceInstanceResource) Delete(ctx context.Context, req res
 	_, err = deleteStateConf.WaitForStateContext(ctx)
 
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Service Instance (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Service Instance (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -1,13 +1,26 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"regexp"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
 )
 
 type XsuaaParameters struct {
@@ -226,6 +239,71 @@ func TestResourceSubaccountServiceInstance(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path - share and unshare", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_service_instance.shared")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountServiceInstanceSharedBySubaccountByServicePlan("uut", "integration-test-services-static", "tf-test-shared-destination", "lite", "destination", true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("btp_subaccount_service_instance.uut", "id", regexpValidUUID),
+						resource.TestCheckResourceAttr("btp_subaccount_service_instance.uut", "name", "tf-test-shared-destination"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_instance.uut", "shared", "true"),
+					),
+				},
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountServiceInstanceSharedBySubaccountByServicePlan("uut", "integration-test-services-static", "tf-test-shared-destination", "lite", "destination", false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_service_instance.uut", "name", "tf-test-shared-destination"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_instance.uut", "shared", "false"),
+					),
+				},
+				{
+					// removing the attribute from the configuration keeps the sharing of the service instance
+					Config: hclProviderFor(user) + hclResourceSubaccountServiceInstanceWoParametersBySubaccountByServicePlan("uut", "integration-test-services-static", "tf-test-shared-destination", "lite", "destination"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_service_instance.uut", "shared", "false"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - service creation by offering and plan name", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_service_instance.by_offering_and_plan_name")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountServiceInstanceBySubaccountByOfferingAndPlanName("uut", "integration-test-services-static", "tf-test-audit-log", "default", "auditlog-management"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("btp_subaccount_service_instance.uut", "id", regexpValidUUID),
+						resource.TestMatchResourceAttr("btp_subaccount_service_instance.uut", "serviceplan_id", regexpValidUUID),
+						resource.TestCheckResourceAttr("btp_subaccount_service_instance.uut", "offering_name", "auditlog-management"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_instance.uut", "plan_name", "default"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_instance.uut", "name", "tf-test-audit-log"),
+					),
+				},
+				{
+					// a change of another attribute keeps the service plan which has been resolved by its name
+					Config: hclProviderFor(user) + hclResourceSubaccountServiceInstanceBySubaccountByOfferingAndPlanName("uut", "integration-test-services-static", "tf-test-audit-log-renamed", "default", "auditlog-management"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPair("btp_subaccount_service_instance.uut", "serviceplan_id", "data.btp_subaccount_service_plan.default", "id"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_instance.uut", "plan_name", "default"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_instance.uut", "name", "tf-test-audit-log-renamed"),
+					),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - subacount_id mandatory", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
@@ -415,6 +493,40 @@ func hclResourceSubaccountServiceInstanceWithLabelsChangedBySubaccountByPlan(res
 		}`, resourceName, subaccountName, name, servicePlanName, serviceOfferingName)
 }
 
+func hclResourceSubaccountServiceInstanceSharedBySubaccountByServicePlan(resourceName string, subaccountName string, name string, servicePlanName string, serviceOfferingName string, shared bool) string {
+	return fmt.Sprintf(`
+		data "btp_subaccounts" "all" {}
+		data "btp_subaccount_service_plans" "all" {
+			subaccount_id = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+		}
+		data "btp_subaccount_service_offering" "so" {
+			subaccount_id = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+			name =  "%[5]s"
+		}
+		resource "btp_subaccount_service_instance" "%[1]s"{
+		    subaccount_id    = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+			name             = "%[3]s"
+		    serviceplan_id   = [for ssp in data.btp_subaccount_service_plans.all.values : ssp.id if ssp.name == "%[4]s" && ssp.serviceoffering_id == data.btp_subaccount_service_offering.so.id][0]
+			shared           = %[6]t
+		}`, resourceName, subaccountName, name, servicePlanName, serviceOfferingName, shared)
+}
+
+func hclResourceSubaccountServiceInstanceBySubaccountByOfferingAndPlanName(resourceName string, subaccountName string, name string, servicePlanName string, serviceOfferingName string) string {
+	return fmt.Sprintf(`
+		data "btp_subaccounts" "all" {}
+		data "btp_subaccount_service_plan" "%[4]s" {
+			subaccount_id = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+			name          = "%[4]s"
+			offering_name = "%[5]s"
+		}
+		resource "btp_subaccount_service_instance" "%[1]s"{
+		    subaccount_id    = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+			name             = "%[3]s"
+			offering_name    = "%[5]s"
+			plan_name        = "%[4]s"
+		}`, resourceName, subaccountName, name, servicePlanName, serviceOfferingName)
+}
+
 func getServiceInstanceIdForImport(resourceName string) resource.ImportStateIdFunc {
 	return func(state *terraform.State) (string, error) {
 		rs, ok := state.RootModule().Resources[resourceName]
@@ -434,3 +546,350 @@ func getServiceInstanceIdForImportNoServiceInstanceId(resourceName string) resou
 		return rs.Primary.Attributes["subaccount_id"], nil
 	}
 }
+
+// fakeServicePlanServer serves the service plan, service offering and service instance commands of the CLI server for a
+// single service plan, which can be renamed during the test
+type fakeServicePlanServer struct {
+	mu       sync.Mutex
+	planName string
+	shared   bool
+	commands []string
+}
+
+const (
+	fakeSubaccountId  = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	fakeServicePlanId = "b50d1b0b-2059-4f21-a014-2ea87752eb48"
+	fakeOfferingId    = "a5387c0b-141f-4c5a-a1a4-1b7b3c5a3e4e"
+	fakeInstanceId    = "1c4dc1d7-8af5-4b27-b9d3-8ad4e3d0f0b9"
+)
+
+func (srv *fakeServicePlanServer) handle(w http.ResponseWriter, r *http.Request) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	var body struct {
+		ParamValues map[string]string `json:"paramValues"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	params := body.ParamValues
+
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/services/plan") && len(params["id"]) > 0:
+		srv.commands = append(srv.commands, "get plan "+params["id"])
+		fmt.Fprintf(w, `{"id":"%s","name":"%s","service_offering_id":"%s"}`, fakeServicePlanId, srv.planName, fakeOfferingId)
+	case strings.HasSuffix(r.URL.Path, "/services/plan"):
+		srv.commands = append(srv.commands, "get plan "+params["offeringName"]+"/"+params["name"])
+		if params["name"] != srv.planName || params["offeringName"] != "destination" {
+			w.Header().Set(btpcli.HeaderCLIBackendStatus, "404")
+			fmt.Fprintf(w, `{"error":"plan not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"id":"%s","name":"%s","service_offering_id":"%s"}`, fakeServicePlanId, srv.planName, fakeOfferingId)
+	case strings.HasSuffix(r.URL.Path, "/services/offering"):
+		srv.commands = append(srv.commands, "get offering "+params["id"])
+		fmt.Fprintf(w, `{"id":"%s","name":"destination"}`, fakeOfferingId)
+	case strings.HasSuffix(r.URL.Path, "/services/instance") && r.URL.RawQuery == "create":
+		srv.commands = append(srv.commands, "create instance with plan "+params["plan"])
+		fmt.Fprintf(w, `{"id":"%s","name":"%s","subaccount_id":"%s","service_plan_id":"%s","last_operation":{"state":"%s"}}`, fakeInstanceId, params["name"], fakeSubaccountId, params["plan"], servicemanager.StateInProgress)
+	case strings.HasSuffix(r.URL.Path, "/services/instance") && (r.URL.RawQuery == "share" || r.URL.RawQuery == "unshare"):
+		srv.commands = append(srv.commands, r.URL.RawQuery+" instance")
+		srv.shared = r.URL.RawQuery == "share"
+		fmt.Fprintf(w, "{}")
+	case strings.HasSuffix(r.URL.Path, "/services/instance"):
+		if r.URL.RawQuery == "update" {
+			// the update is accepted and its result is read afterwards
+			srv.commands = append(srv.commands, "update instance")
+			w.Header().Set(btpcli.HeaderCLIBackendStatus, "202")
+			fmt.Fprintf(w, "{}")
+			return
+		}
+		fmt.Fprintf(w, `{"id":"%s","name":"my-instance","subaccount_id":"%s","service_plan_id":"%s","ready":true,"usable":true,"shared":%t,"last_operation":{"state":"%s"}}`, fakeInstanceId, fakeSubaccountId, fakeServicePlanId, srv.shared, servicemanager.StateSucceeded)
+	}
+}
+
+func TestSubaccountServiceInstanceResource_ServicePlanNames(t *testing.T) {
+	instanceState := func(t *testing.T, uut *subaccountServiceInstanceResource, servicePlanId string, offeringName attr.Value, planName attr.Value) tfsdk.State {
+		return newTestState(t, uut, map[string]attr.Value{
+			"subaccount_id":  types.StringValue(fakeSubaccountId),
+			"id":             types.StringValue(fakeInstanceId),
+			"name":           types.StringValue("my-instance"),
+			"serviceplan_id": types.StringValue(servicePlanId),
+			"offering_name":  offeringName,
+			"plan_name":      planName,
+			"shared":         types.BoolValue(false),
+		})
+	}
+	plannedInstance := func(t *testing.T, uut *subaccountServiceInstanceResource, servicePlanId attr.Value, offeringName attr.Value, planName attr.Value) tfsdk.Plan {
+		state := newTestState(t, uut, map[string]attr.Value{
+			"subaccount_id":  types.StringValue(fakeSubaccountId),
+			"id":             types.StringUnknown(),
+			"name":           types.StringValue("my-instance"),
+			"serviceplan_id": servicePlanId,
+			"offering_name":  offeringName,
+			"plan_name":      planName,
+			"shared":         types.BoolValue(false),
+		})
+		return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+	}
+	configOf := func(plan tfsdk.Plan) tfsdk.Config {
+		return tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}
+	}
+	noState := func(plan tfsdk.Plan) tfsdk.State {
+		return tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(context.TODO()), nil)}
+	}
+	plannedValues := func(t *testing.T, resp *fwresource.ModifyPlanResponse) subaccountServiceInstanceType {
+		var plan subaccountServiceInstanceType
+		assert.False(t, resp.Plan.Get(context.TODO(), &plan).HasError())
+		return plan
+	}
+	read := func(t *testing.T, uut *subaccountServiceInstanceResource, state tfsdk.State) (*fwresource.ReadResponse, subaccountServiceInstanceType) {
+		resp := &fwresource.ReadResponse{State: state}
+		uut.Read(context.TODO(), fwresource.ReadRequest{State: state}, resp)
+
+		var readState subaccountServiceInstanceType
+		resp.State.Get(context.TODO(), &readState)
+		return resp, readState
+	}
+
+	t.Run("happy path - the service plan of a new instance is resolved by its name", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &fakeServicePlanServer{planName: "lite"}
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		plan := plannedInstance(t, uut, types.StringUnknown(), types.StringValue("destination"), types.StringValue("lite"))
+		planResp := &fwresource.ModifyPlanResponse{Plan: plan}
+		uut.ModifyPlan(context.TODO(), fwresource.ModifyPlanRequest{State: noState(plan), Plan: plan, Config: configOf(plan)}, planResp)
+
+		assert.False(t, planResp.Diagnostics.HasError(), "%v", planResp.Diagnostics)
+		assert.Equal(t, fakeServicePlanId, plannedValues(t, planResp).ServicePlanId.ValueString())
+
+		createResp := &fwresource.CreateResponse{State: noState(plan)}
+		uut.Create(context.TODO(), fwresource.CreateRequest{Plan: planResp.Plan, Config: configOf(plan)}, createResp)
+
+		assert.False(t, createResp.Diagnostics.HasError(), "%v", createResp.Diagnostics)
+		assert.Equal(t, []string{"get plan destination/lite", "create instance with plan " + fakeServicePlanId}, srv.commands)
+
+		var state subaccountServiceInstanceType
+		createResp.State.Get(context.TODO(), &state)
+		assert.Equal(t, fakeServicePlanId, state.ServicePlanId.ValueString())
+		assert.Equal(t, "destination", state.OfferingName.ValueString())
+		assert.Equal(t, "lite", state.PlanName.ValueString())
+	})
+	t.Run("happy path - the names of a new instance are determined from its service plan", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &fakeServicePlanServer{planName: "lite"}
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		plan := plannedInstance(t, uut, types.StringValue(fakeServicePlanId), types.StringUnknown(), types.StringUnknown())
+		config := plannedInstance(t, uut, types.StringValue(fakeServicePlanId), types.StringNull(), types.StringNull())
+		planResp := &fwresource.ModifyPlanResponse{Plan: plan}
+		uut.ModifyPlan(context.TODO(), fwresource.ModifyPlanRequest{State: noState(plan), Plan: plan, Config: configOf(config)}, planResp)
+
+		assert.False(t, planResp.Diagnostics.HasError(), "%v", planResp.Diagnostics)
+		assert.True(t, plannedValues(t, planResp).PlanName.IsUnknown())
+
+		createResp := &fwresource.CreateResponse{State: noState(plan)}
+		uut.Create(context.TODO(), fwresource.CreateRequest{Plan: planResp.Plan, Config: configOf(config)}, createResp)
+
+		assert.False(t, createResp.Diagnostics.HasError(), "%v", createResp.Diagnostics)
+		assert.Equal(t, []string{"create instance with plan " + fakeServicePlanId, "get plan " + fakeServicePlanId, "get offering " + fakeOfferingId}, srv.commands)
+
+		var state subaccountServiceInstanceType
+		createResp.State.Get(context.TODO(), &state)
+		assert.Equal(t, "destination", state.OfferingName.ValueString())
+		assert.Equal(t, "lite", state.PlanName.ValueString())
+	})
+	t.Run("happy path - unchanged names keep the service plan of the state", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &fakeServicePlanServer{planName: "lite"}
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		state := instanceState(t, uut, fakeServicePlanId, types.StringValue("destination"), types.StringValue("lite"))
+		planResp := &fwresource.ModifyPlanResponse{Plan: plannedInstance(t, uut, types.StringValue(fakeServicePlanId), types.StringValue("destination"), types.StringValue("lite"))}
+		uut.ModifyPlan(context.TODO(), fwresource.ModifyPlanRequest{State: state, Plan: planResp.Plan, Config: configOf(planResp.Plan)}, planResp)
+
+		assert.False(t, planResp.Diagnostics.HasError(), "%v", planResp.Diagnostics)
+		assert.Equal(t, fakeServicePlanId, plannedValues(t, planResp).ServicePlanId.ValueString())
+		assert.Empty(t, srv.commands)
+	})
+	t.Run("happy path - a changed plan name of an existing instance is resolved", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &fakeServicePlanServer{planName: "standard"}
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		state := instanceState(t, uut, "0a2d5ea4-5d5c-4bb4-a5b2-7b5d3c0a4e6f", types.StringValue("destination"), types.StringValue("lite"))
+		planResp := &fwresource.ModifyPlanResponse{Plan: plannedInstance(t, uut, types.StringValue("0a2d5ea4-5d5c-4bb4-a5b2-7b5d3c0a4e6f"), types.StringValue("destination"), types.StringValue("standard"))}
+		uut.ModifyPlan(context.TODO(), fwresource.ModifyPlanRequest{State: state, Plan: planResp.Plan, Config: configOf(planResp.Plan)}, planResp)
+
+		assert.False(t, planResp.Diagnostics.HasError(), "%v", planResp.Diagnostics)
+		assert.Equal(t, fakeServicePlanId, plannedValues(t, planResp).ServicePlanId.ValueString())
+		assert.Equal(t, []string{"get plan destination/standard"}, srv.commands)
+	})
+	t.Run("happy path - an exchanged service plan ID marks the names unknown", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &fakeServicePlanServer{planName: "lite"}
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		state := instanceState(t, uut, "0a2d5ea4-5d5c-4bb4-a5b2-7b5d3c0a4e6f", types.StringValue("destination"), types.StringValue("standard"))
+		// the names of the state are taken over into the plan, as they are not configured
+		plan := plannedInstance(t, uut, types.StringValue(fakeServicePlanId), types.StringValue("destination"), types.StringValue("standard"))
+		config := plannedInstance(t, uut, types.StringValue(fakeServicePlanId), types.StringNull(), types.StringNull())
+		planResp := &fwresource.ModifyPlanResponse{Plan: plan}
+		uut.ModifyPlan(context.TODO(), fwresource.ModifyPlanRequest{State: state, Plan: plan, Config: configOf(config)}, planResp)
+
+		assert.False(t, planResp.Diagnostics.HasError(), "%v", planResp.Diagnostics)
+		planned := plannedValues(t, planResp)
+		assert.Equal(t, fakeServicePlanId, planned.ServicePlanId.ValueString())
+		assert.True(t, planned.OfferingName.IsUnknown())
+		assert.True(t, planned.PlanName.IsUnknown())
+		assert.Empty(t, srv.commands)
+	})
+	t.Run("happy path - a plan which can't be found for an existing instance is left to the update", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &fakeServicePlanServer{planName: "standard"}
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		state := instanceState(t, uut, fakeServicePlanId, types.StringValue("destination"), types.StringValue("standard"))
+		planResp := &fwresource.ModifyPlanResponse{Plan: plannedInstance(t, uut, types.StringValue(fakeServicePlanId), types.StringValue("destination"), types.StringValue("lite"))}
+		uut.ModifyPlan(context.TODO(), fwresource.ModifyPlanRequest{State: state, Plan: planResp.Plan, Config: configOf(planResp.Plan)}, planResp)
+
+		assert.False(t, planResp.Diagnostics.HasError(), "%v", planResp.Diagnostics)
+		assert.True(t, plannedValues(t, planResp).ServicePlanId.IsUnknown())
+	})
+	t.Run("error path - a plan which can't be found for a new instance", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &fakeServicePlanServer{planName: "standard"}
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		plan := plannedInstance(t, uut, types.StringUnknown(), types.StringValue("destination"), types.StringValue("lite"))
+		planResp := &fwresource.ModifyPlanResponse{Plan: plan}
+		uut.ModifyPlan(context.TODO(), fwresource.ModifyPlanRequest{State: noState(plan), Plan: plan, Config: configOf(plan)}, planResp)
+
+		assert.True(t, planResp.Diagnostics.HasError())
+	})
+	t.Run("happy path - the read keeps the stored names", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &fakeServicePlanServer{planName: "standard"}
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		resp, readState := read(t, uut, instanceState(t, uut, fakeServicePlanId, types.StringValue("destination"), types.StringValue("lite")))
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Empty(t, srv.commands)
+		assert.Equal(t, "destination", readState.OfferingName.ValueString())
+		assert.Equal(t, "lite", readState.PlanName.ValueString())
+	})
+	t.Run("happy path - the read determines missing names", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &fakeServicePlanServer{planName: "lite"}
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		resp, readState := read(t, uut, instanceState(t, uut, fakeServicePlanId, types.StringNull(), types.StringNull()))
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, []string{"get plan " + fakeServicePlanId, "get offering " + fakeOfferingId}, srv.commands)
+		assert.Equal(t, "destination", readState.OfferingName.ValueString())
+		assert.Equal(t, "lite", readState.PlanName.ValueString())
+	})
+	t.Run("happy path - the read determines the names of an exchanged service plan", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &fakeServicePlanServer{planName: "standard"}
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		resp, readState := read(t, uut, instanceState(t, uut, "0a2d5ea4-5d5c-4bb4-a5b2-7b5d3c0a4e6f", types.StringValue("destination"), types.StringValue("lite")))
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, []string{"get plan " + fakeServicePlanId, "get offering " + fakeOfferingId}, srv.commands)
+		assert.Equal(t, fakeServicePlanId, readState.ServicePlanId.ValueString())
+		assert.Equal(t, "standard", readState.PlanName.ValueString())
+	})
+	t.Run("happy path - the read leaves the names empty if the plan can't be read", func(t *testing.T) {
+		t.Parallel()
+
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasSuffix(r.URL.Path, "/services/instance") {
+				fmt.Fprintf(w, `{"id":"%s","name":"my-instance","subaccount_id":"%s","service_plan_id":"%s","last_operation":{"state":"%s"}}`, fakeInstanceId, fakeSubaccountId, fakeServicePlanId, servicemanager.StateSucceeded)
+				return
+			}
+			w.Header().Set(btpcli.HeaderCLIBackendStatus, "403")
+			fmt.Fprintf(w, `{"error":"forbidden"}`)
+		})}
+
+		resp, readState := read(t, uut, instanceState(t, uut, fakeServicePlanId, types.StringNull(), types.StringNull()))
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+		assert.True(t, readState.OfferingName.IsNull())
+		assert.True(t, readState.PlanName.IsNull())
+	})
+}
+
+func TestSubaccountServiceInstanceResource_Sharing(t *testing.T) {
+	instance := func(t *testing.T, uut *subaccountServiceInstanceResource, shared attr.Value) tfsdk.State {
+		return newTestState(t, uut, map[string]attr.Value{
+			"subaccount_id":  types.StringValue(fakeSubaccountId),
+			"id":             types.StringValue(fakeInstanceId),
+			"name":           types.StringValue("my-instance"),
+			"serviceplan_id": types.StringValue(fakeServicePlanId),
+			"offering_name":  types.StringValue("destination"),
+			"plan_name":      types.StringValue("lite"),
+			"shared":         shared,
+		})
+	}
+	update := func(t *testing.T, uut *subaccountServiceInstanceResource, sharedInConfig types.Bool) *fwresource.UpdateResponse {
+		state := instance(t, uut, types.BoolValue(true))
+		config := instance(t, uut, sharedInConfig)
+		// the planned sharing is taken from the state, if it is not configured
+		plan := instance(t, uut, types.BoolValue(sharedInConfig.IsNull() || sharedInConfig.ValueBool()))
+
+		resp := &fwresource.UpdateResponse{State: state}
+		uut.Update(context.TODO(), fwresource.UpdateRequest{
+			State:  state,
+			Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+			Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		}, resp)
+
+		return resp
+	}
+
+	t.Run("happy path - a sharing which is not configured is kept", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &fakeServicePlanServer{planName: "lite", shared: true}
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		resp := update(t, uut, types.BoolNull())
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, []string{"update instance"}, srv.commands)
+
+		var state subaccountServiceInstanceType
+		resp.State.Get(context.TODO(), &state)
+		assert.True(t, state.Shared.ValueBool())
+	})
+	t.Run("happy path - a configured sharing is applied", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &fakeServicePlanServer{planName: "lite", shared: true}
+		uut := &subaccountServiceInstanceResource{cli: newLoggedInClientFacade(t, srv.handle)}
+
+		resp := update(t, uut, types.BoolValue(false))
+
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, []string{"update instance", "unshare instance"}, srv.commands)
+
+		var state subaccountServiceInstanceType
+		resp.State.Get(context.TODO(), &state)
+		assert.False(t, state.Shared.ValueBool())
+	})
+}
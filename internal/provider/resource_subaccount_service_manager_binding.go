@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newSubaccountServiceManagerBindingResource() resource.Resource {
+	return &subaccountServiceManagerBindingResource{}
+}
+
+type subaccountServiceManagerBindingResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *subaccountServiceManagerBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_subaccount_service_manager_binding", req.ProviderTypeName)
+}
+
+func (rs *subaccountServiceManagerBindingResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *subaccountServiceManagerBindingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Creates a binding of the SAP Service Manager in a subaccount, i.e. OAuth client credentials to manage the services of the subaccount, e.g. with the SAP BTP service operator for Kubernetes.
+
+__Further documentation:__
+<https://help.sap.com/docs/service-manager/sap-service-manager/sap-service-manager>`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the binding.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"clientid": schema.StringAttribute{
+				MarkdownDescription: "A public identifier of the app.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"clientsecret": schema.StringAttribute{
+				MarkdownDescription: "Secret known only to the app and the authorization server.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sm_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Service Management APIs to access with the obtained token.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the authentication server to get a token to authenticate with Service Management using the obtained client ID and secret.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"xsappname": schema.StringAttribute{
+				MarkdownDescription: "The name of the xsapp used to get the access token.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (rs *subaccountServiceManagerBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountServiceManagerBindingType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, rawRes, err := rs.cli.Accounts.ServiceManagerBinding.Get(ctx, state.SubaccountId.ValueString(), state.Name.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, resp, err, "Resource Service Manager Binding (Subaccount)")
+		return
+	}
+
+	newState := subaccountServiceManagerBindingValueFrom(state.SubaccountId.ValueString(), state.Name.ValueString(), cliRes)
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountServiceManagerBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan subaccountServiceManagerBindingType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, _, err := rs.cli.Accounts.ServiceManagerBinding.Create(ctx, plan.SubaccountId.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Service Manager Binding (Subaccount)", errorDetail(err))
+		return
+	}
+
+	state := subaccountServiceManagerBindingValueFrom(plan.SubaccountId.ValueString(), plan.Name.ValueString(), cliRes)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountServiceManagerBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	// All configurable attributes require a replacement of the binding
+	resp.Diagnostics.AddError("API Error Updating Resource Service Manager Binding (Subaccount)", "This resource is not supposed to be updated")
+}
+
+func (rs *subaccountServiceManagerBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountServiceManagerBindingType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := rs.cli.Accounts.ServiceManagerBinding.Delete(ctx, state.SubaccountId.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Service Manager Binding (Subaccount)", errorDetail(err))
+		return
+	}
+}
+
+func (rs *subaccountServiceManagerBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: subaccount_id,name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceSubaccountServiceManagerBinding(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_service_manager_binding")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountServiceManagerBinding("uut", "integration-test-services-static", "tf-test-sm-binding"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("btp_subaccount_service_manager_binding.uut", "subaccount_id", regexpValidUUID),
+						resource.TestCheckResourceAttr("btp_subaccount_service_manager_binding.uut", "name", "tf-test-sm-binding"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_service_manager_binding.uut", "clientid"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_service_manager_binding.uut", "clientsecret"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_service_manager_binding.uut", "sm_url"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_service_manager_binding.uut", "url"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_service_manager_binding.uut", "xsappname"),
+					),
+				},
+				{
+					ResourceName:                         "btp_subaccount_service_manager_binding.uut",
+					ImportStateIdFunc:                    getServiceManagerBindingImportStateId("btp_subaccount_service_manager_binding.uut"),
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "name",
+				},
+				{
+					// a renamed binding can't be updated, so it is replaced
+					Config: hclProviderFor(user) + hclResourceSubaccountServiceManagerBinding("uut", "integration-test-services-static", "tf-test-sm-binding-renamed"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("btp_subaccount_service_manager_binding.uut", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_service_manager_binding.uut", "name", "tf-test-sm-binding-renamed"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_service_manager_binding.uut", "clientsecret"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - import with wrong key", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_service_manager_binding.import_error")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountServiceManagerBinding("uut", "integration-test-services-static", "tf-test-sm-binding"),
+				},
+				{
+					ResourceName:                         "btp_subaccount_service_manager_binding.uut",
+					ImportStateId:                        "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "name",
+					ExpectError:                          regexp.MustCompile(`Expected import identifier with format: subaccount_id,name. Got:`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - subaccount_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_subaccount_service_manager_binding" "uut" {
+	subaccount_id = "this-is-not-a-uuid"
+	name          = "tf-test-sm-binding"
+}`,
+					ExpectError: regexp.MustCompile(`Attribute subaccount_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - name must not be empty", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_subaccount_service_manager_binding" "uut" {
+	subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	name          = ""
+}`,
+					ExpectError: regexp.MustCompile(`Attribute name string length must be at least 1, got: 0`),
+				},
+			},
+		})
+	})
+}
+
+func hclResourceSubaccountServiceManagerBinding(resourceName string, subaccountName string, name string) string {
+	return fmt.Sprintf(`
+		data "btp_subaccounts" "all" {}
+		resource "btp_subaccount_service_manager_binding" "%[1]s" {
+			subaccount_id = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+			name          = "%[3]s"
+		}`, resourceName, subaccountName, name)
+}
+
+func getServiceManagerBindingImportStateId(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["subaccount_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
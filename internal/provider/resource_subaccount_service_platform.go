@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newSubaccountServicePlatformResource() resource.Resource {
+	return &subaccountServicePlatformResource{}
+}
+
+type subaccountServicePlatformResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *subaccountServicePlatformResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_subaccount_service_platform", req.ProviderTypeName)
+}
+
+func (rs *subaccountServicePlatformResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *subaccountServicePlatformResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Registers a platform, e.g. a Kubernetes cluster, in a subaccount, so that it can consume the services of the subaccount.
+
+__Further documentation:__
+<https://help.sap.com/docs/service-manager/sap-service-manager/working-with-platforms>`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the platform.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the platform, e.g. `kubernetes`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the platform.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.SetType{
+					ElemType: types.StringType,
+				},
+				MarkdownDescription: "The set of words or phrases assigned to the platform.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the platform.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"credentials": schema.StringAttribute{
+				MarkdownDescription: "The credentials which are needed to deploy the Service Manager agent in the platform. The credentials are only returned when the platform is registered, so they are not available after an import.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ready": schema.BoolAttribute{
+				MarkdownDescription: "Shows whether the platform is ready for consumption.",
+				Computed:            true,
+			},
+			"created_date": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was last modified in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (rs *subaccountServicePlatformResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountServicePlatformType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, rawRes, err := rs.cli.Services.Platform.GetById(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, resp, err, "Resource Service Platform (Subaccount)")
+		return
+	}
+
+	newState, diags := subaccountServicePlatformValueFrom(ctx, state.SubaccountId.ValueString(), state.Credentials, cliRes)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountServicePlatformResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan subaccountServicePlatformType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliReq := btpcli.ServicePlatformRegisterInput{
+		Subaccount:  plan.SubaccountId.ValueString(),
+		Name:        plan.Name.ValueString(),
+		Type:        plan.PlatformType.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+
+	if !plan.Labels.IsNull() && !plan.Labels.IsUnknown() {
+		var labels map[string][]string
+		plan.Labels.ElementsAs(ctx, &labels, false)
+
+		cliReq.Labels = labels
+	}
+
+	cliRes, _, err := rs.cli.Services.Platform.Register(ctx, &cliReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Service Platform (Subaccount)", errorDetail(err))
+		return
+	}
+
+	credentials := types.StringNull()
+	if cliRes.Credentials != nil {
+		credentialsJson, err := json.Marshal(cliRes.Credentials)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Creating Resource Service Platform (Subaccount)", errorDetail(err))
+			return
+		}
+
+		credentials = types.StringValue(string(credentialsJson))
+	}
+
+	state, diags := subaccountServicePlatformValueFrom(ctx, plan.SubaccountId.ValueString(), credentials, servicemanager.PlatformResponseObject{
+		Id:          cliRes.Id,
+		Ready:       cliRes.Ready,
+		Type_:       cliRes.Type_,
+		Name:        cliRes.Name,
+		Description: cliRes.Description,
+		CreatedAt:   cliRes.CreatedAt,
+		UpdatedAt:   cliRes.UpdatedAt,
+		Labels:      cliRes.Labels,
+	})
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountServicePlatformResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var stateCurrent, plan subaccountServicePlatformType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &stateCurrent)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliReq := btpcli.ServicePlatformUpdateInput{
+		Id:          stateCurrent.Id.ValueString(),
+		Subaccount:  plan.SubaccountId.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+
+	if plan.Name.ValueString() != stateCurrent.Name.ValueString() {
+		cliReq.NewName = plan.Name.ValueString()
+	}
+
+	// Labels of plan and state need to be transferred as a delta must be computed for the update operation
+	if !plan.Labels.IsNull() && !plan.Labels.IsUnknown() {
+		var labelsFromPlan map[string][]string
+		plan.Labels.ElementsAs(ctx, &labelsFromPlan, false)
+
+		cliReq.LabelsPlan = labelsFromPlan
+	}
+
+	if !stateCurrent.Labels.IsNull() {
+		var labelsFromState map[string][]string
+		stateCurrent.Labels.ElementsAs(ctx, &labelsFromState, false)
+
+		cliReq.LabelsState = labelsFromState
+	}
+
+	cliRes, _, err := rs.cli.Services.Platform.Update(ctx, &cliReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Service Platform (Subaccount)", errorDetail(err))
+		return
+	}
+
+	state, diags := subaccountServicePlatformValueFrom(ctx, plan.SubaccountId.ValueString(), stateCurrent.Credentials, cliRes)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountServicePlatformResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var state subaccountServicePlatformType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := rs.cli.Services.Platform.Unregister(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Service Platform (Subaccount)", errorDetail(err))
+		return
+	}
+}
+
+func (rs *subaccountServicePlatformResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: subaccount_id,id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceSubaccountServicePlatform(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_service_platform")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountServicePlatform("uut", "integration-test-services-static", "tf-test-platform", "kubernetes", "My Kubernetes cluster"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("btp_subaccount_service_platform.uut", "id", regexpValidUUID),
+						resource.TestMatchResourceAttr("btp_subaccount_service_platform.uut", "subaccount_id", regexpValidUUID),
+						resource.TestCheckResourceAttr("btp_subaccount_service_platform.uut", "name", "tf-test-platform"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_platform.uut", "type", "kubernetes"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_platform.uut", "description", "My Kubernetes cluster"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_platform.uut", "labels.team.0", "platform"),
+						resource.TestCheckResourceAttrSet("btp_subaccount_service_platform.uut", "credentials"),
+						resource.TestMatchResourceAttr("btp_subaccount_service_platform.uut", "created_date", regexpValidRFC3999Format),
+						resource.TestMatchResourceAttr("btp_subaccount_service_platform.uut", "last_modified", regexpValidRFC3999Format),
+					),
+				},
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountServicePlatform("uut", "integration-test-services-static", "tf-test-platform-renamed", "kubernetes", "My updated Kubernetes cluster"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_service_platform.uut", "name", "tf-test-platform-renamed"),
+						resource.TestCheckResourceAttr("btp_subaccount_service_platform.uut", "description", "My updated Kubernetes cluster"),
+						// the credentials are only returned by the registration and kept by the update
+						resource.TestCheckResourceAttrSet("btp_subaccount_service_platform.uut", "credentials"),
+					),
+				},
+				{
+					ResourceName:      "btp_subaccount_service_platform.uut",
+					ImportStateIdFunc: getServicePlatformImportStateId("btp_subaccount_service_platform.uut"),
+					ImportState:       true,
+					ImportStateVerify: true,
+					// the credentials are not returned by the API after the registration
+					ImportStateVerifyIgnore: []string{"credentials"},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - import with wrong key", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_service_platform.import_error")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountServicePlatform("uut", "integration-test-services-static", "tf-test-platform", "kubernetes", "My Kubernetes cluster"),
+				},
+				{
+					ResourceName:      "btp_subaccount_service_platform.uut",
+					ImportStateId:     "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f",
+					ImportState:       true,
+					ImportStateVerify: true,
+					ExpectError:       regexp.MustCompile(`Expected import identifier with format: subaccount_id,id. Got:`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - subaccount_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_subaccount_service_platform" "uut" {
+	subaccount_id = "this-is-not-a-uuid"
+	name          = "tf-test-platform"
+	type          = "kubernetes"
+}`,
+					ExpectError: regexp.MustCompile(`Attribute subaccount_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - type is mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_subaccount_service_platform" "uut" {
+	subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	name          = "tf-test-platform"
+}`,
+					ExpectError: regexp.MustCompile(`The argument "type" is required, but no definition was found`),
+				},
+			},
+		})
+	})
+}
+
+func hclResourceSubaccountServicePlatform(resourceName string, subaccountName string, name string, platformType string, description string) string {
+	return fmt.Sprintf(`
+		data "btp_subaccounts" "all" {}
+		resource "btp_subaccount_service_platform" "%[1]s" {
+			subaccount_id = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%[2]s"][0]
+			name          = "%[3]s"
+			type          = "%[4]s"
+			description   = "%[5]s"
+			labels        = {"team" = ["platform"]}
+		}`, resourceName, subaccountName, name, platformType, description)
+}
+
+func getServicePlatformImportStateId(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["subaccount_id"], rs.Primary.ID), nil
+	}
+}
@@ -57,22 +57,39 @@ You must be assigned to the subaccount admin role.`,
 				Validators: []validator.String{
 					uuidvalidator.ValidUUID(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"app_name": schema.StringAttribute{
 				MarkdownDescription: "The unique registration name of the deployed multitenant application as defined by the app developer.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"plan_name": schema.StringAttribute{
-				MarkdownDescription: "The plan name of the application to which the consumer has subscribed.",
+				MarkdownDescription: "The plan name of the application to which the consumer has subscribed. The plan is changed in place if the application supports plan updates, otherwise the subscription is replaced.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfUpdateNotSupported("supports_plan_updates"),
+						"The subscription is replaced if the application does not support plan updates.",
+						"The subscription is replaced if the application does not support plan updates.",
+					),
+				},
 			},
 			"parameters": schema.StringAttribute{
-				MarkdownDescription: "The parameters of the subscription as a valid JSON object.",
+				MarkdownDescription: "The parameters of the subscription as a valid JSON object. The parameters are changed in place if the application supports parameter updates, otherwise the subscription is replaced.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(`{}`),
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfUpdateNotSupported("supports_parameters_updates"),
+						"The subscription is replaced if the application does not support parameter updates.",
+						"The subscription is replaced if the application does not support parameter updates.",
+					),
 					stringplanmodifier.UseStateForUnknown(),
 				},
 				Validators: []validator.String{
@@ -183,6 +200,8 @@ You must be assigned to the subaccount admin role.`,
 }
 
 func (rs *subaccountSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountSubscriptionType
 
 	diags := req.State.Get(ctx, &state)
@@ -215,6 +234,8 @@ func (rs *subaccountSubscriptionResource) Read(ctx context.Context, req resource
 }
 
 func (rs *subaccountSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountSubscriptionType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -222,9 +243,15 @@ func (rs *subaccountSubscriptionResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, plan.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	_, _, err := rs.cli.Accounts.Subaccount.Subscribe(ctx, plan.SubaccountId.ValueString(), plan.AppName.ValueString(), plan.PlanName.ValueString(), plan.Parameters.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Subscription (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Subscription (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -232,7 +259,7 @@ func (rs *subaccountSubscriptionResource) Create(ctx context.Context, req resour
 		Pending: []string{saas_manager_service.StateInProcess},
 		Target:  []string{saas_manager_service.StateSubscribed},
 		Refresh: func() (interface{}, string, error) {
-			subRes, _, err := rs.cli.Accounts.Subscription.Get(ctx, plan.SubaccountId.ValueString(), plan.AppName.ValueString(), plan.PlanName.ValueString())
+			subRes, _, err := rs.cli.Accounts.Subscription.Get(btpcli.WithoutReadCache(ctx), plan.SubaccountId.ValueString(), plan.AppName.ValueString(), plan.PlanName.ValueString())
 
 			if err != nil {
 				return subRes, "", err
@@ -252,7 +279,7 @@ func (rs *subaccountSubscriptionResource) Create(ctx context.Context, req resour
 
 	updatedRes, err := createStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Subscription (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Subscription (Subaccount)", errorDetail(err))
 	}
 
 	updatedPlan, diags := subaccountSubscriptionValueFrom(ctx, updatedRes.(saas_manager_service.EntitledApplicationsResponseObject))
@@ -264,20 +291,96 @@ func (rs *subaccountSubscriptionResource) Create(ctx context.Context, req resour
 }
 
 func (rs *subaccountSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan subaccountSubscriptionType
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
+	var plan, state subaccountSubscriptionType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.AddError("API Error Updating Subscription (Subaccount)", "This resource is not supposed to be updated")
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, unlock := lockSubaccount(ctx, rs.cli, plan.SubaccountId.ValueString(), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	defer unlock()
+
+	// Only the changed values are transferred, as e.g. not every application accepts parameter updates
+	var planName, parameters string
+
+	if !plan.PlanName.Equal(state.PlanName) {
+		planName = plan.PlanName.ValueString()
+	}
+
+	if !plan.Parameters.Equal(state.Parameters) {
+		parameters = plan.Parameters.ValueString()
+	}
+
+	if len(planName) > 0 || len(parameters) > 0 {
+		_, _, err := rs.cli.Accounts.Subscription.Update(ctx, plan.SubaccountId.ValueString(), plan.AppName.ValueString(), planName, parameters)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Updating Resource Subscription (Subaccount)", errorDetail(err))
+			return
+		}
+	}
+
+	updateStateConf := &tfutils.StateChangeConf{
+		Pending: []string{saas_manager_service.StateInProcess},
+		Target:  []string{saas_manager_service.StateSubscribed},
+		Refresh: func() (interface{}, string, error) {
+			subRes, _, err := rs.cli.Accounts.Subscription.Get(btpcli.WithoutReadCache(ctx), plan.SubaccountId.ValueString(), plan.AppName.ValueString(), plan.PlanName.ValueString())
+
+			if err != nil {
+				return subRes, "", err
+			}
+
+			// No error returned even if update failed
+			if subRes.State == saas_manager_service.StateUpdateFailed || subRes.State == saas_manager_service.StateUpdateParametersFailed {
+				return subRes, subRes.State, errors.New("undefined API error during subscription update")
+			}
+
+			return subRes, subRes.State, nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	updatedRes, err := updateStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Subscription (Subaccount)", errorDetail(err))
+		return
+	}
+
+	updatedPlan, diags := subaccountSubscriptionValueFrom(ctx, updatedRes.(saas_manager_service.EntitledApplicationsResponseObject))
+	updatedPlan.Parameters = plan.Parameters
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &updatedPlan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// requiresReplaceIfUpdateNotSupported replaces the subscription if the given capability of the application, as stored in the state, does not allow an update in place
+func requiresReplaceIfUpdateNotSupported(supportsUpdatesAttribute string) stringplanmodifier.RequiresReplaceIfFunc {
+	return func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		var supportsUpdates types.Bool
+
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(supportsUpdatesAttribute), &supportsUpdates)...)
+
+		resp.RequiresReplace = !supportsUpdates.ValueBool()
+	}
 }
 
 func (rs *subaccountSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountSubscriptionType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -285,9 +388,15 @@ func (rs *subaccountSubscriptionResource) Delete(ctx context.Context, req resour
 		return
 	}
 
+	ctx, unlock := lockSubaccount(ctx, rs.cli, state.SubaccountId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	_, _, err := rs.cli.Accounts.Subaccount.Unsubscribe(ctx, state.SubaccountId.ValueString(), state.AppName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Subscription (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Subscription (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -295,7 +404,7 @@ func (rs *subaccountSubscriptionResource) Delete(ctx context.Context, req resour
 		Pending: []string{saas_manager_service.StateInProcess},
 		Target:  []string{saas_manager_service.StateNotSubscribed},
 		Refresh: func() (interface{}, string, error) {
-			subRes, _, err := rs.cli.Accounts.Subscription.Get(ctx, state.SubaccountId.ValueString(), state.AppName.ValueString(), state.PlanName.ValueString())
+			subRes, _, err := rs.cli.Accounts.Subscription.Get(btpcli.WithoutReadCache(ctx), state.SubaccountId.ValueString(), state.AppName.ValueString(), state.PlanName.ValueString())
 
 			if err != nil {
 				return subRes, subRes.State, err
@@ -315,7 +424,7 @@ func (rs *subaccountSubscriptionResource) Delete(ctx context.Context, req resour
 
 	_, err = deleteStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Subscription (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Subscription (Subaccount)", errorDetail(err))
 		return
 	}
 }
@@ -1,12 +1,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestResourceSubaccountSubscription(t *testing.T) {
@@ -43,6 +51,73 @@ func TestResourceSubaccountSubscription(t *testing.T) {
 			},
 		})
 	})
+	t.Run("happy path - plan and parameters are updated in place", func(t *testing.T) {
+		// the application must support plan and parameter updates
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_subscription.update_in_place")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountSubscriptionWithParametersBySubaccount("uut", "integration-test-services-static", "sapappstudio", "standard-edition", `{"size":"small"}`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_subscription.uut", "plan_name", "standard-edition"),
+						resource.TestCheckResourceAttr("btp_subaccount_subscription.uut", "parameters", `{"size":"small"}`),
+						resource.TestCheckResourceAttr("btp_subaccount_subscription.uut", "supports_plan_updates", "true"),
+						resource.TestCheckResourceAttr("btp_subaccount_subscription.uut", "supports_parameters_updates", "true"),
+						resource.TestCheckResourceAttr("btp_subaccount_subscription.uut", "state", "SUBSCRIBED"),
+					),
+				},
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountSubscriptionWithParametersBySubaccount("uut", "integration-test-services-static", "sapappstudio", "build-code", `{"size":"large"}`),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("btp_subaccount_subscription.uut", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_subscription.uut", "plan_name", "build-code"),
+						resource.TestCheckResourceAttr("btp_subaccount_subscription.uut", "parameters", `{"size":"large"}`),
+						resource.TestCheckResourceAttr("btp_subaccount_subscription.uut", "state", "SUBSCRIBED"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - subscription is replaced if the plan can't be updated", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount_subscription.replace")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountSubscriptionBySubaccount("uut", "integration-test-services-static", "auditlog-viewer", "free"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_subscription.uut", "plan_name", "free"),
+						resource.TestCheckResourceAttr("btp_subaccount_subscription.uut", "supports_plan_updates", "false"),
+					),
+				},
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountSubscriptionBySubaccount("uut", "integration-test-services-static", "auditlog-viewer", "default"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("btp_subaccount_subscription.uut", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_subscription.uut", "plan_name", "default"),
+						resource.TestCheckResourceAttr("btp_subaccount_subscription.uut", "state", "SUBSCRIBED"),
+					),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - subacount_id mandatory", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
@@ -116,6 +191,17 @@ func hclResourceSubaccountSubscriptionBySubaccount(resourceName string, subaccou
 		}`, resourceName, subaccountName, appName, planName)
 }
 
+func hclResourceSubaccountSubscriptionWithParametersBySubaccount(resourceName string, subaccountName string, appName string, planName string, parameters string) string {
+	return fmt.Sprintf(`
+		data "btp_subaccounts" "all" {}
+		resource "btp_subaccount_subscription" "%s"{
+			subaccount_id = [for sa in data.btp_subaccounts.all.values : sa.id if sa.name == "%s"][0]
+			app_name         = "%s"
+			plan_name        = "%s"
+			parameters       = %q
+		}`, resourceName, subaccountName, appName, planName, parameters)
+}
+
 func hclResourceSubaccountSubscriptionNoSubaccountId(resourceName string, appName string, planName string) string {
 
 	return fmt.Sprintf(`
@@ -162,3 +248,35 @@ func getSubscriptionImportStateIdNoAppNameNoPlanName(resourceName string) resour
 		return rs.Primary.Attributes["subaccount_id"], nil
 	}
 }
+
+func TestRequiresReplaceIfUpdateNotSupported(t *testing.T) {
+	tests := []struct {
+		description     string
+		supportsUpdates attr.Value
+		expectsReplace  bool
+	}{
+		{description: "update supported", supportsUpdates: types.BoolValue(true), expectsReplace: false},
+		{description: "update not supported", supportsUpdates: types.BoolValue(false), expectsReplace: true},
+		{description: "capability unknown", supportsUpdates: types.BoolNull(), expectsReplace: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			state := newTestState(t, &subaccountSubscriptionResource{}, map[string]attr.Value{
+				"plan_name":             types.StringValue("free"),
+				"supports_plan_updates": test.supportsUpdates,
+			})
+
+			resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+			requiresReplaceIfUpdateNotSupported("supports_plan_updates")(context.TODO(), planmodifier.StringRequest{
+				Path:       path.Root("plan_name"),
+				State:      state,
+				StateValue: types.StringValue("free"),
+				PlanValue:  types.StringValue("default"),
+			}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.Equal(t, test.expectsReplace, resp.RequiresReplace)
+		})
+	}
+}
@@ -1,14 +1,24 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis"
 )
 
 func TestResourceSubaccount(t *testing.T) {
@@ -208,6 +218,57 @@ func TestResourceSubaccount(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path move to directory and back", func(t *testing.T) {
+		rec, user := setupVCR(t, "fixtures/resource_subaccount.move")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountInGlobalAccount("uut", "integration-test-acc-dyn", "eu12", "integration-test-acc-dyn"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("btp_subaccount.uut", "id", regexpValidUUID),
+						resource.TestCheckResourceAttrPair("btp_subaccount.uut", "parent_id", "data.btp_globalaccount.this", "id"),
+						resource.TestCheckResourceAttr("btp_subaccount.uut", "state", "OK"),
+					),
+				},
+				{
+					// a changed parent moves the subaccount instead of replacing it
+					Config: hclProviderFor(user) + hclResourceSubaccountInDirectory("uut", "integration-test-dir-static", "integration-test-acc-dyn", "eu12", "integration-test-acc-dyn"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("btp_subaccount.uut", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPair("btp_subaccount.uut", "parent_id", "data.btp_directory.parent", "id"),
+						resource.TestCheckResourceAttr("btp_subaccount.uut", "name", "integration-test-acc-dyn"),
+						resource.TestCheckResourceAttr("btp_subaccount.uut", "state", "OK"),
+					),
+				},
+				{
+					Config: hclProviderFor(user) + hclResourceSubaccountInGlobalAccount("uut", "integration-test-acc-dyn", "eu12", "integration-test-acc-dyn"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("btp_subaccount.uut", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPair("btp_subaccount.uut", "parent_id", "data.btp_globalaccount.this", "id"),
+						resource.TestCheckResourceAttr("btp_subaccount.uut", "state", "OK"),
+					),
+				},
+				{
+					ResourceName:      "btp_subaccount.uut",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+
 	t.Run("error path - parent_id not a valid UUID", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
@@ -306,6 +367,35 @@ resource "btp_subaccount" "%s" {
 	return fmt.Sprintf(template, resourceName, parentId, displayName, region, subdomain)
 }
 
+func hclResourceSubaccountInGlobalAccount(resourceName string, displayName string, region string, subdomain string) string {
+	template := `
+data "btp_globalaccount" "this" {}
+resource "btp_subaccount" "%s" {
+    parent_id = data.btp_globalaccount.this.id
+    name      = "%s"
+    region    = "%s"
+    subdomain = "%s"
+}`
+
+	return fmt.Sprintf(template, resourceName, displayName, region, subdomain)
+}
+
+func hclResourceSubaccountInDirectory(resourceName string, directoryName string, displayName string, region string, subdomain string) string {
+	template := `
+data "btp_directories" "all" {}
+data "btp_directory" "parent" {
+    id = [for dir in data.btp_directories.all.values : dir.id if dir.name == "%s"][0]
+}
+resource "btp_subaccount" "%s" {
+    parent_id = data.btp_directory.parent.id
+    name      = "%s"
+    region    = "%s"
+    subdomain = "%s"
+}`
+
+	return fmt.Sprintf(template, directoryName, resourceName, displayName, region, subdomain)
+}
+
 func hclResourceSubaccountUsedForProd(resourceName string, displayName string, region string, subdomain string) string {
 	template := `
 resource "btp_subaccount" "%s" {
@@ -317,3 +407,133 @@ resource "btp_subaccount" "%s" {
 
 	return fmt.Sprintf(template, resourceName, displayName, region, subdomain)
 }
+
+func TestSubaccountResource_ReconcileAdmins(t *testing.T) {
+	toSet := func(admins ...string) types.Set {
+		set, _ := types.SetValueFrom(context.TODO(), types.StringType, admins)
+		return set
+	}
+
+	newResource := func(t *testing.T, failingUser string) *subaccountResource {
+		return &subaccountResource{cli: newLoggedInClientFacade(t, func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				ParamValues map[string]string `json:"paramValues"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+
+			if body.ParamValues["userName"] == failingUser {
+				w.Header().Set(btpcli.HeaderCLIBackendStatus, "400")
+				fmt.Fprintf(w, `{"error":"user could not be assigned"}`)
+				return
+			}
+
+			fmt.Fprintf(w, "{}")
+		})}
+	}
+
+	t.Run("happy path - the planned admins are applied", func(t *testing.T) {
+		uut := newResource(t, "")
+
+		admins, err := uut.reconcileAdmins(context.TODO(), "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", toSet("jane.doe@test.com"), toSet("john.doe@test.com"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, toSet("john.doe@test.com"), admins)
+	})
+	t.Run("happy path - an empty set unassigns all admins", func(t *testing.T) {
+		uut := newResource(t, "")
+
+		admins, err := uut.reconcileAdmins(context.TODO(), "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", toSet("jane.doe@test.com"), toSet())
+
+		assert.NoError(t, err)
+		assert.Empty(t, admins.Elements())
+	})
+	t.Run("happy path - removed admins attribute is not reconciled", func(t *testing.T) {
+		uut := newResource(t, "jane.doe@test.com")
+
+		admins, err := uut.reconcileAdmins(context.TODO(), "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", toSet("jane.doe@test.com"), types.SetNull(types.StringType))
+
+		assert.NoError(t, err)
+		assert.True(t, admins.IsNull())
+	})
+	t.Run("error path - only the admins which are applied are returned", func(t *testing.T) {
+		uut := newResource(t, "max.mustermann@test.com")
+
+		admins, err := uut.reconcileAdmins(context.TODO(), "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", toSet("jane.doe@test.com"), toSet("john.doe@test.com", "max.mustermann@test.com"))
+
+		assert.Error(t, err)
+		assert.ElementsMatch(t, []attr.Value{types.StringValue("jane.doe@test.com"), types.StringValue("john.doe@test.com")}, admins.Elements())
+	})
+}
+
+func TestSubaccountResource_MoveSubaccount(t *testing.T) {
+	globalAccountId := "e7a1ab22-2f6d-4b3e-b9a8-5a4cbb7c1c65"
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	directoryId := "5357bda0-8651-4eab-a69d-12d282bc3247"
+
+	newResource := func(t *testing.T, moveParams map[string]string, finalState string) *subaccountResource {
+		return &subaccountResource{cli: newLoggedInClientFacade(t, func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				ParamValues map[string]string `json:"paramValues"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/accounts/global-account"):
+				fmt.Fprintf(w, `{"guid":"%s"}`, globalAccountId)
+			case strings.HasSuffix(r.URL.Path, "/accounts/subaccount") && r.URL.RawQuery == string(btpcli.ActionMove):
+				for key, value := range body.ParamValues {
+					moveParams[key] = value
+				}
+				fmt.Fprintf(w, `{"guid":"%s","state":"%s"}`, subaccountId, cis.StateMoving)
+			case strings.HasSuffix(r.URL.Path, "/accounts/subaccount") && r.URL.RawQuery == string(btpcli.ActionGet):
+				fmt.Fprintf(w, `{"guid":"%s","state":"%s","stateMessage":"Subaccount moving failed."}`, subaccountId, finalState)
+			default:
+				w.Header().Set(btpcli.HeaderCLIBackendStatus, "404")
+				fmt.Fprintf(w, `{"error":"unexpected command"}`)
+			}
+		})}
+	}
+
+	t.Run("happy path - move to a directory", func(t *testing.T) {
+		t.Parallel()
+		moveParams := map[string]string{}
+		uut := newResource(t, moveParams, cis.StateOK)
+
+		err := uut.moveSubaccount(context.TODO(), subaccountId, directoryId)
+
+		assert.NoError(t, err)
+		assert.Equal(t, subaccountId, moveParams["subaccount"])
+		assert.Equal(t, directoryId, moveParams["toDirectory"])
+		assert.NotContains(t, moveParams, "toGlobalAccount")
+	})
+	t.Run("happy path - move to the global account", func(t *testing.T) {
+		t.Parallel()
+		moveParams := map[string]string{}
+		uut := newResource(t, moveParams, cis.StateOK)
+
+		err := uut.moveSubaccount(context.TODO(), subaccountId, globalAccountId)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "my-globalaccount", moveParams["toGlobalAccount"])
+		assert.NotContains(t, moveParams, "toDirectory")
+	})
+	t.Run("error path - move failed", func(t *testing.T) {
+		t.Parallel()
+		uut := newResource(t, map[string]string{}, cis.StateMoveFailed)
+
+		err := uut.moveSubaccount(context.TODO(), subaccountId, directoryId)
+
+		assert.EqualError(t, err, "the subaccount could not be moved, it is in state MOVE_FAILED: Subaccount moving failed.")
+	})
+	t.Run("error path - move does not finish in time", func(t *testing.T) {
+		t.Parallel()
+		uut := newResource(t, map[string]string{}, cis.StateMoving)
+
+		ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+		defer cancel()
+
+		err := uut.moveSubaccount(ctx, subaccountId, directoryId)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -167,6 +167,8 @@ __Further documentation:__
 }
 
 func (rs *subaccountTrustConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountTrustConfigurationType
 
 	diags := req.State.Get(ctx, &state)
@@ -192,6 +194,8 @@ func (rs *subaccountTrustConfigurationResource) Read(ctx context.Context, req re
 }
 
 func (rs *subaccountTrustConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountTrustConfigurationType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -227,7 +231,7 @@ func (rs *subaccountTrustConfigurationResource) Create(ctx context.Context, req
 
 	createRes, _, err := rs.cli.Security.Trust.CreateBySubaccount(ctx, plan.SubaccountId.ValueString(), cliCreateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Trust Configuration (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Creating Resource Trust Configuration (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -251,7 +255,7 @@ func (rs *subaccountTrustConfigurationResource) Create(ctx context.Context, req
 
 	updateRes, _, err := rs.cli.Security.Trust.UpdateBySubaccount(ctx, plan.SubaccountId.ValueString(), cliUpdateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Trust Configuration after Creation (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Trust Configuration after Creation (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -264,6 +268,8 @@ func (rs *subaccountTrustConfigurationResource) Create(ctx context.Context, req
 }
 
 func (rs *subaccountTrustConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var plan subaccountTrustConfigurationType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -317,7 +323,7 @@ func (rs *subaccountTrustConfigurationResource) Update(ctx context.Context, req
 
 	updateRes, _, err := rs.cli.Security.Trust.UpdateBySubaccount(ctx, plan.SubaccountId.ValueString(), cliUpdateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Updating Resource Trust Configuration (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Updating Resource Trust Configuration (Subaccount)", errorDetail(err))
 		return
 	}
 
@@ -330,6 +336,8 @@ func (rs *subaccountTrustConfigurationResource) Update(ctx context.Context, req
 }
 
 func (rs *subaccountTrustConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendServerWarnings(rs.cli, &resp.Diagnostics)
+
 	var state subaccountTrustConfigurationType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -348,7 +356,7 @@ func (rs *subaccountTrustConfigurationResource) Delete(ctx context.Context, req
 
 	_, _, err := rs.cli.Security.Trust.DeleteBySubaccount(ctx, state.SubaccountId.ValueString(), state.Origin.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Trust Configuration (Subaccount)", fmt.Sprintf("%s", err))
+		resp.Diagnostics.AddError("API Error Deleting Resource Trust Configuration (Subaccount)", errorDetail(err))
 		return
 	}
 }
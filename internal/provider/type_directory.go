@@ -12,8 +12,8 @@ import (
 
 const (
 	DirectoryFeatureDefault        = "DEFAULT"
-	DirectoryFeatureAuthorizations = "ENTITLEMENTS"
-	DirectoryFeatureEntitlements   = "AUTHORIZATIONS"
+	DirectoryFeatureAuthorizations = "AUTHORIZATIONS"
+	DirectoryFeatureEntitlements   = "ENTITLEMENTS"
 )
 
 type directoryType struct {
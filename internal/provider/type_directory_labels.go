@@ -0,0 +1,12 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type directoryLabelsType struct {
+	DirectoryId   types.String `tfsdk:"directory_id"`
+	Id            types.String `tfsdk:"id"`
+	Labels        types.Map    `tfsdk:"labels"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+}
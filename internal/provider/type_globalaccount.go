@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis"
+)
+
+type globalaccountResourceType struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Usage           types.String `tfsdk:"usage"`
+	CommercialModel types.String `tfsdk:"commercial_model"`
+	ContractStatus  types.String `tfsdk:"contract_status"`
+	CreatedDate     types.String `tfsdk:"created_date"`
+	GeoAccess       types.String `tfsdk:"geo_access"`
+	LastModified    types.String `tfsdk:"last_modified"`
+	LicenseType     types.String `tfsdk:"license_type"`
+	State           types.String `tfsdk:"state"`
+	Subdomain       types.String `tfsdk:"subdomain"`
+}
+
+func globalaccountResourceValueFrom(value cis.GlobalAccountResponseObject) globalaccountResourceType {
+	return globalaccountResourceType{
+		ID:              types.StringValue(value.Guid),
+		Name:            types.StringValue(value.DisplayName),
+		Description:     types.StringValue(value.Description),
+		Usage:           stringNullIfEmpty(value.UseFor),
+		CommercialModel: types.StringValue(value.CommercialModel),
+		ContractStatus:  types.StringValue(value.ContractStatus),
+		CreatedDate:     timeToValue(value.CreatedDate.Time()),
+		GeoAccess:       types.StringValue(value.GeoAccess),
+		LastModified:    timeToValue(value.ModifiedDate.Time()),
+		LicenseType:     types.StringValue(value.LicenseType),
+		State:           types.StringValue(value.EntityState),
+		Subdomain:       types.StringValue(value.Subdomain),
+	}
+}
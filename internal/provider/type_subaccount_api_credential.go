@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/xsuaa_api"
+)
+
+type subaccountApiCredentialType struct {
+	SubaccountId        types.String `tfsdk:"subaccount_id"`
+	Name                types.String `tfsdk:"name"`
+	CertificatePassed   types.String `tfsdk:"certificate_passed"`
+	ReadOnly            types.Bool   `tfsdk:"read_only"`
+	ClientId            types.String `tfsdk:"client_id"`
+	ClientSecret        types.String `tfsdk:"client_secret"`
+	CertificateReceived types.String `tfsdk:"certificate_received"`
+	Key                 types.String `tfsdk:"key"`
+	TokenUrl            types.String `tfsdk:"token_url"`
+	ApiUrl              types.String `tfsdk:"api_url"`
+	CredentialType      types.String `tfsdk:"credential_type"`
+}
+
+// subaccountApiCredentialValueFrom maps the API credential returned by the API. The secrets are only returned when the API credential is created, so they are taken over from the given state.
+func subaccountApiCredentialValueFrom(subaccountId string, config subaccountApiCredentialType, value xsuaa_api.ApiCredential) subaccountApiCredentialType {
+	apiCredential := subaccountApiCredentialType{
+		SubaccountId:        types.StringValue(subaccountId),
+		Name:                config.Name,
+		CertificatePassed:   config.CertificatePassed,
+		ReadOnly:            types.BoolValue(value.ReadOnly),
+		ClientId:            types.StringValue(value.ClientID),
+		ClientSecret:        config.ClientSecret,
+		CertificateReceived: config.CertificateReceived,
+		Key:                 config.Key,
+		TokenUrl:            types.StringValue(value.TokenURL),
+		ApiUrl:              types.StringValue(value.APIURL),
+		CredentialType:      types.StringValue(value.CredentialType),
+	}
+
+	if len(value.ClientSecret) > 0 {
+		apiCredential.ClientSecret = types.StringValue(value.ClientSecret)
+	}
+
+	if len(value.Certificate) > 0 {
+		apiCredential.CertificateReceived = types.StringValue(value.Certificate)
+	}
+
+	if len(value.Key) > 0 {
+		apiCredential.Key = types.StringValue(value.Key)
+	}
+
+	return apiCredential
+}
@@ -0,0 +1,12 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type subaccountLabelsType struct {
+	SubaccountId  types.String `tfsdk:"subaccount_id"`
+	Id            types.String `tfsdk:"id"`
+	Labels        types.Map    `tfsdk:"labels"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+}
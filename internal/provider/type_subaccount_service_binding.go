@@ -1,11 +1,15 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis"
 	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
 	"github.com/SAP/terraform-provider-btp/internal/tfutils"
 )
@@ -20,6 +24,8 @@ type subaccountServiceBindingType struct {
 	Context           types.String `tfsdk:"context"`
 	BindResource      types.Map    `tfsdk:"bind_resource"`
 	Credentials       types.String `tfsdk:"credentials"`
+	CredentialsMap    types.Map    `tfsdk:"credentials_map"`
+	OAuthCredentials  types.Object `tfsdk:"oauth_credentials"`
 	State             types.String `tfsdk:"state"`
 	CreatedDate       types.String `tfsdk:"created_date"`
 	LastModified      types.String `tfsdk:"last_modified"`
@@ -45,6 +51,9 @@ func subaccountServiceBindingValueFrom(ctx context.Context, value servicemanager
 	serviceBinding.BindResource, diags = types.MapValueFrom(ctx, types.StringType, value.BindResource)
 	diagnostics.Append(diags...)
 
+	serviceBinding.CredentialsMap, serviceBinding.OAuthCredentials, diags = serviceBindingCredentialsValueFrom(ctx, value.Credentials)
+	diagnostics.Append(diags...)
+
 	//Remove computed labels to avoid state inconsistencies
 	value.Labels = tfutils.RemoveComputedlabels(value.Labels)
 
@@ -53,3 +62,125 @@ func subaccountServiceBindingValueFrom(ctx context.Context, value servicemanager
 
 	return serviceBinding, diagnostics
 }
+
+// subaccountServiceBindingResourceType extends the service binding by the settings and results of the credential rotation, which are only available for the resource
+type subaccountServiceBindingResourceType struct {
+	SubaccountId           types.String `tfsdk:"subaccount_id"`
+	ServiceInstanceId      types.String `tfsdk:"service_instance_id"`
+	Name                   types.String `tfsdk:"name"`
+	Parameters             types.String `tfsdk:"parameters"`
+	Id                     types.String `tfsdk:"id"`
+	Ready                  types.Bool   `tfsdk:"ready"`
+	Context                types.String `tfsdk:"context"`
+	BindResource           types.Map    `tfsdk:"bind_resource"`
+	Credentials            types.String `tfsdk:"credentials"`
+	CredentialsMap         types.Map    `tfsdk:"credentials_map"`
+	OAuthCredentials       types.Object `tfsdk:"oauth_credentials"`
+	State                  types.String `tfsdk:"state"`
+	CreatedDate            types.String `tfsdk:"created_date"`
+	LastModified           types.String `tfsdk:"last_modified"`
+	Labels                 types.Map    `tfsdk:"labels"`
+	RotationPeriod         types.String `tfsdk:"rotation_period"`
+	RotationTrigger        types.String `tfsdk:"rotation_trigger"`
+	RotationOverlap        types.String `tfsdk:"rotation_overlap"`
+	PreviousId             types.String `tfsdk:"previous_id"`
+	PreviousCredentials    types.String `tfsdk:"previous_credentials"`
+	PreviousExpirationDate types.String `tfsdk:"previous_expiration_date"`
+}
+
+// subaccountServiceBindingResourceValueFrom maps the given binding and takes over the settings and the previous binding of the rotation from the given configuration
+func subaccountServiceBindingResourceValueFrom(ctx context.Context, value servicemanager.ServiceBindingResponseObject, config subaccountServiceBindingResourceType) (subaccountServiceBindingResourceType, diag.Diagnostics) {
+	binding, diags := subaccountServiceBindingValueFrom(ctx, value)
+
+	return subaccountServiceBindingResourceType{
+		SubaccountId:           binding.SubaccountId,
+		ServiceInstanceId:      binding.ServiceInstanceId,
+		Name:                   binding.Name,
+		Parameters:             binding.Parameters,
+		Id:                     binding.Id,
+		Ready:                  binding.Ready,
+		Context:                binding.Context,
+		BindResource:           binding.BindResource,
+		Credentials:            binding.Credentials,
+		CredentialsMap:         binding.CredentialsMap,
+		OAuthCredentials:       binding.OAuthCredentials,
+		State:                  binding.State,
+		CreatedDate:            binding.CreatedDate,
+		LastModified:           binding.LastModified,
+		Labels:                 binding.Labels,
+		RotationPeriod:         config.RotationPeriod,
+		RotationTrigger:        config.RotationTrigger,
+		RotationOverlap:        config.RotationOverlap,
+		PreviousId:             config.PreviousId,
+		PreviousCredentials:    config.PreviousCredentials,
+		PreviousExpirationDate: config.PreviousExpirationDate,
+	}, diags
+}
+
+var serviceBindingOAuthCredentialsType = map[string]attr.Type{
+	"clientid":     types.StringType,
+	"clientsecret": types.StringType,
+	"url":          types.StringType,
+	"xsappname":    types.StringType,
+}
+
+type serviceBindingOAuthCredentials struct {
+	cis.ServiceManagementBindingResponseObject
+
+	// Some services, e.g. SAP HANA Cloud or the destination service, nest their OAuth client in the 'uaa' section
+	Uaa *cis.ServiceManagementBindingResponseObject `json:"uaa,omitempty"`
+}
+
+// serviceBindingCredentialsValueFrom decodes the credentials of a binding. Nested objects and arrays are kept as JSON
+// strings in the map, as their shape differs between the services. The OAuth client is only set if the credentials contain one.
+func serviceBindingCredentialsValueFrom(ctx context.Context, credentials json.RawMessage) (types.Map, types.Object, diag.Diagnostics) {
+	credentialsMap := types.MapNull(types.StringType)
+	oauthCredentials := types.ObjectNull(serviceBindingOAuthCredentialsType)
+
+	var rawValues map[string]json.RawMessage
+	if err := json.Unmarshal(credentials, &rawValues); err != nil || rawValues == nil {
+		return credentialsMap, oauthCredentials, nil
+	}
+
+	values := map[string]string{}
+	for key, rawValue := range rawValues {
+		var value string
+		if err := json.Unmarshal(rawValue, &value); err != nil {
+			var compactValue bytes.Buffer
+			if err := json.Compact(&compactValue, rawValue); err != nil {
+				return credentialsMap, oauthCredentials, nil
+			}
+
+			value = compactValue.String()
+		}
+
+		values[key] = value
+	}
+
+	var diags, diagnostics diag.Diagnostics
+
+	credentialsMap, diags = types.MapValueFrom(ctx, types.StringType, values)
+	diagnostics.Append(diags...)
+
+	var oauth serviceBindingOAuthCredentials
+	if err := json.Unmarshal(credentials, &oauth); err != nil {
+		return credentialsMap, oauthCredentials, diagnostics
+	}
+
+	client := oauth.ServiceManagementBindingResponseObject
+	if len(client.Clientid) == 0 && oauth.Uaa != nil {
+		client = *oauth.Uaa
+	}
+
+	if len(client.Clientid) > 0 {
+		oauthCredentials, diags = types.ObjectValue(serviceBindingOAuthCredentialsType, map[string]attr.Value{
+			"clientid":     types.StringValue(client.Clientid),
+			"clientsecret": types.StringValue(client.Clientsecret),
+			"url":          types.StringValue(client.Url),
+			"xsappname":    types.StringValue(client.Xsappname),
+		})
+		diagnostics.Append(diags...)
+	}
+
+	return credentialsMap, oauthCredentials, diagnostics
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
+)
+
+type subaccountServiceBrokerType struct {
+	SubaccountId types.String `tfsdk:"subaccount_id"`
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Url          types.String `tfsdk:"url"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	Ready        types.Bool   `tfsdk:"ready"`
+	CreatedDate  types.String `tfsdk:"created_date"`
+	LastModified types.String `tfsdk:"last_modified"`
+	Labels       types.Map    `tfsdk:"labels"`
+}
+
+// subaccountServiceBrokerValueFrom maps the broker returned by the API. The credentials are not returned by the API, so they are taken over from the given configuration.
+func subaccountServiceBrokerValueFrom(ctx context.Context, subaccountId string, credentials subaccountServiceBrokerType, value servicemanager.ServiceBrokerResponseObject) (subaccountServiceBrokerType, diag.Diagnostics) {
+	serviceBroker := subaccountServiceBrokerType{
+		SubaccountId: types.StringValue(subaccountId),
+		Id:           types.StringValue(value.Id),
+		Name:         types.StringValue(value.Name),
+		Description:  types.StringValue(value.Description),
+		Url:          types.StringValue(value.BrokerUrl),
+		Username:     credentials.Username,
+		Password:     credentials.Password,
+		Ready:        types.BoolValue(value.Ready),
+		CreatedDate:  timeToValue(value.CreatedAt),
+		LastModified: timeToValue(value.UpdatedAt),
+	}
+
+	var diags diag.Diagnostics
+
+	//Remove computed labels to avoid state inconsistencies
+	value.Labels = tfutils.RemoveComputedlabels(value.Labels)
+
+	serviceBroker.Labels, diags = types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, value.Labels)
+
+	return serviceBroker, diags
+}
@@ -18,6 +18,8 @@ type subaccountServiceInstanceType struct {
 	Parameters           types.String   `tfsdk:"parameters"`
 	Ready                types.Bool     `tfsdk:"ready"`
 	ServicePlanId        types.String   `tfsdk:"serviceplan_id"`
+	OfferingName         types.String   `tfsdk:"offering_name"`
+	PlanName             types.String   `tfsdk:"plan_name"`
 	PlatformId           types.String   `tfsdk:"platform_id"`
 	ReferencedInstanceId types.String   `tfsdk:"referenced_instance_id"`
 	Shared               types.Bool     `tfsdk:"shared"`
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis"
+)
+
+type subaccountServiceManagerBindingType struct {
+	SubaccountId types.String `tfsdk:"subaccount_id"`
+	Name         types.String `tfsdk:"name"`
+	Clientid     types.String `tfsdk:"clientid"`
+	Clientsecret types.String `tfsdk:"clientsecret"`
+	SmUrl        types.String `tfsdk:"sm_url"`
+	Url          types.String `tfsdk:"url"`
+	Xsappname    types.String `tfsdk:"xsappname"`
+}
+
+func subaccountServiceManagerBindingValueFrom(subaccountId string, name string, value cis.ServiceManagementBindingResponseObject) subaccountServiceManagerBindingType {
+	return subaccountServiceManagerBindingType{
+		SubaccountId: types.StringValue(subaccountId),
+		Name:         types.StringValue(name),
+		Clientid:     types.StringValue(value.Clientid),
+		Clientsecret: types.StringValue(value.Clientsecret),
+		SmUrl:        types.StringValue(value.SmUrl),
+		Url:          types.StringValue(value.Url),
+		Xsappname:    types.StringValue(value.Xsappname),
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
+)
+
+type subaccountServicePlatformType struct {
+	SubaccountId types.String `tfsdk:"subaccount_id"`
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	PlatformType types.String `tfsdk:"type"`
+	Description  types.String `tfsdk:"description"`
+	Credentials  types.String `tfsdk:"credentials"`
+	Ready        types.Bool   `tfsdk:"ready"`
+	CreatedDate  types.String `tfsdk:"created_date"`
+	LastModified types.String `tfsdk:"last_modified"`
+	Labels       types.Map    `tfsdk:"labels"`
+}
+
+// subaccountServicePlatformValueFrom maps the platform returned by the API. The credentials are only returned when the platform is registered, so they are taken over from the given state.
+func subaccountServicePlatformValueFrom(ctx context.Context, subaccountId string, credentials types.String, value servicemanager.PlatformResponseObject) (subaccountServicePlatformType, diag.Diagnostics) {
+	servicePlatform := subaccountServicePlatformType{
+		SubaccountId: types.StringValue(subaccountId),
+		Id:           types.StringValue(value.Id),
+		Name:         types.StringValue(value.Name),
+		PlatformType: types.StringValue(value.Type_),
+		Description:  types.StringValue(value.Description),
+		Credentials:  credentials,
+		Ready:        types.BoolValue(value.Ready),
+		CreatedDate:  timeToValue(value.CreatedAt),
+		LastModified: timeToValue(value.UpdatedAt),
+	}
+
+	var diags diag.Diagnostics
+
+	//Remove computed labels to avoid state inconsistencies
+	value.Labels = tfutils.RemoveComputedlabels(value.Labels)
+
+	servicePlatform.Labels, diags = types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, value.Labels)
+
+	return servicePlatform, diags
+}
@@ -67,7 +67,7 @@ func parseCliTag(tag reflect.StructTag) (cliParam string, encoder paramsEncoder,
 	}
 
 	if len(tagValue) > 1 && tagValue[1] == "json" {
-		encoder = &jsonEncoder{}
+		encoder = &jsonEncoder{omitEmpty: len(tagValue) > 2 && tagValue[2] == "omitempty"}
 	} else {
 		encoder = &autoEncoder{}
 	}
@@ -232,10 +232,26 @@ func setContains[S ~[]E, E any](set S, element E, isEqual equalityPredicate[E])
 	return false
 }
 
+// jsonEncoder encodes a field as JSON. With the tag option 'omitempty' (e.g. `btpcli:"admins,json,omitempty"`) the
+// parameter is left out for nil pointers and empty slices and maps, as they would otherwise be sent as 'null' or '[]'.
 type jsonEncoder struct {
+	omitEmpty bool
 }
 
 func (je *jsonEncoder) Encode(_ reflect.Type, field reflect.Value) (string, error) {
+	if je.omitEmpty {
+		switch field.Kind() {
+		case reflect.Pointer:
+			if field.IsNil() {
+				return "", nil
+			}
+		case reflect.Slice, reflect.Map:
+			if field.Len() == 0 {
+				return "", nil
+			}
+		}
+	}
+
 	arr, err := json.Marshal(field.Interface())
 
 	return string(arr), err
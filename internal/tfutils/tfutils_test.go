@@ -122,6 +122,90 @@ func TestToBTPCLIParamsMap(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "happy path - nil slice as json",
+			uut: struct {
+				Features []string `btpcli:"directoryFeatures,json"`
+			}{},
+			expects: expects{
+				output: map[string]string{
+					"directoryFeatures": "null",
+				},
+			},
+		},
+		{
+			description: "happy path - empty slice as json",
+			uut: struct {
+				Features []string `btpcli:"directoryFeatures,json"`
+			}{
+				Features: []string{},
+			},
+			expects: expects{
+				output: map[string]string{
+					"directoryFeatures": "[]",
+				},
+			},
+		},
+		{
+			description: "happy path - slice as json with omitempty",
+			uut: struct {
+				Admins []string `btpcli:"admins,json,omitempty"`
+			}{
+				Admins: []string{"jane.doe@test.com"},
+			},
+			expects: expects{
+				output: map[string]string{
+					"admins": "[\"jane.doe@test.com\"]",
+				},
+			},
+		},
+		{
+			description: "happy path - nil slice as json with omitempty",
+			uut: struct {
+				Admins []string `btpcli:"admins,json,omitempty"`
+			}{},
+			expects: expectsNOP,
+		},
+		{
+			description: "happy path - empty slice as json with omitempty",
+			uut: struct {
+				Admins []string `btpcli:"admins,json,omitempty"`
+			}{
+				Admins: []string{},
+			},
+			expects: expectsNOP,
+		},
+		{
+			description: "happy path - nil map as json with omitempty",
+			uut: struct {
+				Labels map[string]string `btpcli:"labels,json,omitempty"`
+			}{},
+			expects: expectsNOP,
+		},
+		{
+			description: "happy path - nil pointer as json with omitempty",
+			uut: struct {
+				Settings *struct{ A string } `btpcli:"settings,json,omitempty"`
+			}{},
+			expects: expectsNOP,
+		},
+		{
+			description: "happy path - pointer as json with omitempty",
+			uut: struct {
+				Settings *struct {
+					A string `json:"a"`
+				} `btpcli:"settings,json,omitempty"`
+			}{
+				Settings: &struct {
+					A string `json:"a"`
+				}{A: "b"},
+			},
+			expects: expects{
+				output: map[string]string{
+					"settings": "{\"a\":\"b\"}",
+				},
+			},
+		},
 		{
 			description: "happy path - map as json",
 			uut: struct {
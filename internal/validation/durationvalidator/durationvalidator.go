@@ -0,0 +1,44 @@
+package durationvalidator
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type durationValidator struct {
+}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v durationValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a positive duration, e.g. `90m` or `720h`"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue
+
+	if duration, err := time.ParseDuration(value.ValueString()); err == nil && duration > 0 {
+		return
+	}
+
+	response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		request.Path,
+		v.Description(ctx),
+		value.String(),
+	))
+}
+
+// ValidDuration checks that the String held in the attribute
+// is a positive duration as understood by time.ParseDuration
+func ValidDuration() validator.String {
+	return durationValidator{}
+}